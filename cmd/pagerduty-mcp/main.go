@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/auth"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
@@ -16,9 +17,19 @@ import (
 func main() {
 	// Parse command line flags
 	enableWriteTools := flag.Bool("enable-write-tools", false, "Enable write operations (create, update, delete)")
+	writeCategories := flag.String("write-categories", "", "Comma-separated write tool categories to enable (default: all when write tools are enabled)")
+	allowedTools := flag.String("allowed-tools", "", "Comma-separated tool names to expose (default: all registered tools)")
+	deniedTools := flag.String("denied-tools", "", "Comma-separated tool names to hide (takes precedence over --allowed-tools)")
+	requireConfirmation := flag.Bool("require-confirmation", false, "Require a confirmation token before destructive tools run")
+	dryRun := flag.Bool("dry-run", false, "Make every write tool call a dry run that returns the request instead of sending it")
+	auditLogPath := flag.String("audit-log", "", "File to append write tool audit records to ('-' for stderr)")
 	httpMode := flag.Bool("http", false, "Run in HTTP mode instead of stdio")
 	host := flag.String("host", "127.0.0.1", "Host to listen on in HTTP mode")
 	port := flag.Int("port", 3000, "Port to listen on in HTTP mode")
+	rateLimit := flag.Int("rate-limit", 0, "Maximum requests per minute per bearer token in HTTP mode (0 disables)")
+	rateLimitBurst := flag.Int("rate-limit-burst", 0, "Requests a bearer token may burst above the rate (default: the rate)")
+	sessions := flag.Bool("sessions", false, "Issue an Mcp-Session-Id on initialize and require it on later requests (HTTP mode)")
+	tokenMapFile := flag.String("token-map-file", "", "JSON file mapping bearer tokens to PagerDuty API tokens (HTTP mode)")
 	flag.Parse()
 
 	// Load .env file if it exists
@@ -39,17 +50,58 @@ func main() {
 	}
 
 	// Create MCP server
-	mcpSrv := server.New(server.Config{
-		EnableWriteTools: *enableWriteTools,
-	}, pdClient)
+	cfg := server.Config{
+		EnableWriteTools:    *enableWriteTools,
+		AllowedTools:        splitList(*allowedTools),
+		DeniedTools:         splitList(*deniedTools),
+		RequireConfirmation: *requireConfirmation,
+		DryRun:              *dryRun,
+	}
+	if *writeCategories != "" {
+		cfg.WriteCategories, err = server.ParseWriteCategories(*writeCategories)
+		if err != nil {
+			log.Fatalf("Invalid --write-categories: %v", err)
+		}
+	}
+	switch *auditLogPath {
+	case "":
+	case "-":
+		cfg.AuditLog = os.Stderr
+	default:
+		auditFile, err := os.OpenFile(*auditLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			log.Fatalf("Failed to open audit log: %v", err)
+		}
+		defer auditFile.Close()
+		cfg.AuditLog = auditFile
+	}
+	mcpSrv := server.New(cfg, pdClient)
 
 	if *httpMode {
 		// Run in HTTP mode
 		fmt.Fprintf(os.Stderr, "Running in HTTP mode on %s:%d\n", *host, *port)
+
+		var authorizer auth.Authorizer = &auth.MockAuthorizer{}
+		if *tokenMapFile != "" {
+			store, err := auth.LoadTokenStoreFile(*tokenMapFile)
+			if err != nil {
+				log.Fatalf("Failed to load token map: %v", err)
+			}
+			authorizer = &auth.TokenMappingAuthorizer{
+				Authorizer: authorizer,
+				Store:      store,
+			}
+		}
+
 		httpServer := server.NewHTTPServer(mcpSrv, server.HTTPConfig{
-			Host:       *host,
-			Port:       *port,
-			Authorizer: &auth.MockAuthorizer{},
+			Host:           *host,
+			Port:           *port,
+			Authorizer:     authorizer,
+			ReadinessCheck: pdClient.Ping,
+
+			RateLimitPerMinute: *rateLimit,
+			RateLimitBurst:     *rateLimitBurst,
+			EnableSessions:     *sessions,
 		})
 		if err := httpServer.RunHTTP(); err != nil {
 			log.Fatalf("HTTP server error: %v", err)
@@ -61,3 +113,14 @@ func main() {
 		}
 	}
 }
+
+// splitList splits a comma-separated flag value, dropping empty entries
+func splitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -11,14 +11,17 @@ type ContextKey string
 const (
 	// PagerDutyTokenKey is the context key for storing the PagerDuty token
 	PagerDutyTokenKey ContextKey = "pagerduty_token"
+
+	// FromEmailKey is the context key for storing the From email sent with PagerDuty requests
+	FromEmailKey ContextKey = "pagerduty_from_email"
 )
 
 // Middleware creates an HTTP middleware that requires authorization
 func Middleware(authorizer Authorizer) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Skip auth for health endpoint
-			if r.URL.Path == "/health" {
+			// Skip auth for health and readiness endpoints
+			if r.URL.Path == "/health" || r.URL.Path == "/ready" {
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -42,12 +45,32 @@ func Middleware(authorizer Authorizer) func(http.Handler) http.Handler {
 				return
 			}
 
-			// Check for X-PagerDuty-Token header and add to context
+			// Resolve the PagerDuty token mapped to the bearer token, falling
+			// back to the X-PagerDuty-Token header
 			ctx := r.Context()
-			if pdToken := r.Header.Get("X-PagerDuty-Token"); pdToken != "" {
+			pdToken := ""
+			if resolver, ok := authorizer.(TokenResolver); ok {
+				token, found, err := resolver.ResolvePagerDutyToken(ctx, bearerToken(authHeader))
+				if err != nil {
+					http.Error(w, `{"error":"Token resolution failed"}`, http.StatusInternalServerError)
+					return
+				}
+				if found {
+					pdToken = token
+				}
+			}
+			if pdToken == "" {
+				pdToken = r.Header.Get("X-PagerDuty-Token")
+			}
+			if pdToken != "" {
 				ctx = context.WithValue(ctx, PagerDutyTokenKey, pdToken)
 			}
 
+			// Check for X-PagerDuty-From header and add to context
+			if from := r.Header.Get("X-PagerDuty-From"); from != "" {
+				ctx = WithFromEmail(ctx, from)
+			}
+
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -58,3 +81,14 @@ func GetPagerDutyToken(ctx context.Context) (string, bool) {
 	token, ok := ctx.Value(PagerDutyTokenKey).(string)
 	return token, ok
 }
+
+// WithFromEmail returns a copy of ctx carrying the From email for PagerDuty requests
+func WithFromEmail(ctx context.Context, email string) context.Context {
+	return context.WithValue(ctx, FromEmailKey, email)
+}
+
+// GetFromEmail retrieves the From email from context if present
+func GetFromEmail(ctx context.Context) (string, bool) {
+	email, ok := ctx.Value(FromEmailKey).(string)
+	return email, ok
+}
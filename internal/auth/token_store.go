@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// TokenStore looks up the PagerDuty API token associated with a bearer token
+type TokenStore interface {
+	LookupPagerDutyToken(ctx context.Context, bearerToken string) (string, bool, error)
+}
+
+// TokenResolver is implemented by authorizers that can map a validated bearer
+// token to a PagerDuty API token held by the gateway
+type TokenResolver interface {
+	ResolvePagerDutyToken(ctx context.Context, bearerToken string) (string, bool, error)
+}
+
+// StaticTokenStore is an in-memory TokenStore keyed by bearer token
+type StaticTokenStore map[string]string
+
+// LookupPagerDutyToken returns the PagerDuty token mapped to the bearer token
+func (s StaticTokenStore) LookupPagerDutyToken(ctx context.Context, bearerToken string) (string, bool, error) {
+	token, ok := s[bearerToken]
+	return token, ok && token != "", nil
+}
+
+// LoadTokenStoreFile loads a StaticTokenStore from a JSON file containing an
+// object that maps bearer tokens to PagerDuty API tokens
+func LoadTokenStoreFile(path string) (StaticTokenStore, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token map: %w", err)
+	}
+
+	store := make(StaticTokenStore)
+	if err := json.Unmarshal(data, &store); err != nil {
+		return nil, fmt.Errorf("failed to parse token map: %w", err)
+	}
+	return store, nil
+}
+
+// TokenMappingAuthorizer wraps an Authorizer and resolves per-user PagerDuty
+// tokens from a TokenStore once the bearer token has been authorized
+type TokenMappingAuthorizer struct {
+	Authorizer Authorizer
+	Store      TokenStore
+}
+
+// Authorize delegates to the wrapped authorizer
+func (a *TokenMappingAuthorizer) Authorize(ctx context.Context, token string) (bool, error) {
+	return a.Authorizer.Authorize(ctx, token)
+}
+
+// ResolvePagerDutyToken looks up the PagerDuty token for the bearer token
+func (a *TokenMappingAuthorizer) ResolvePagerDutyToken(ctx context.Context, bearerToken string) (string, bool, error) {
+	if a.Store == nil {
+		return "", false, nil
+	}
+	return a.Store.LookupPagerDutyToken(ctx, bearerToken)
+}
+
+// bearerToken strips the "Bearer " scheme from an Authorization header value
+func bearerToken(authHeader string) string {
+	const prefix = "bearer "
+	if len(authHeader) > len(prefix) && strings.EqualFold(authHeader[:len(prefix)], prefix) {
+		return strings.TrimSpace(authHeader[len(prefix):])
+	}
+	return authHeader
+}
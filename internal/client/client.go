@@ -2,36 +2,83 @@ package client
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/auth"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/version"
 )
 
 const (
 	DefaultAPIHost = "https://api.pagerduty.com"
-	UserAgent      = "go-mcp-pagerduty/0.1.0"
 )
 
 // Client is the PagerDuty API client
 type Client struct {
-	apiKey     string
-	apiHost    string
-	httpClient *http.Client
-	fromEmail  string
+	apiKey         string
+	apiHost        string
+	httpClient     *http.Client
+	requestTimeout time.Duration
+	compression    bool
+	etags          *etagCache
+	userAgent      string
+	defaultHeaders map[string]string
+	logger         *slog.Logger
+	logBodies      bool
+
+	mu        sync.RWMutex
+	fromEmail string
+
+	events *EventsClient
 }
 
 // Config holds the client configuration
 type Config struct {
 	APIKey  string
 	APIHost string
+
+	// EventsHost is the Events API host. When empty it is derived from
+	// APIHost, so EU accounts use the EU events endpoint.
+	EventsHost string
+
+	// RequestTimeout bounds each API request. Zero leaves only the caller's
+	// context deadline and the 30s HTTP client timeout.
+	RequestTimeout time.Duration
+
+	// EnableCompression requests gzip-compressed responses, which saves
+	// bandwidth on large list responses
+	EnableCompression bool
+
+	// EnableETagCache revalidates repeated GET requests with If-None-Match and
+	// serves the cached body when PagerDuty responds 304 Not Modified
+	EnableETagCache bool
+
+	// UserAgent replaces the default go-mcp-pagerduty/<version> User-Agent
+	UserAgent string
+
+	// DefaultHeaders are added to every request, e.g. a tracing or tenant
+	// header. They cannot replace the Authorization, Accept, Content-Type, or
+	// User-Agent headers.
+	DefaultHeaders map[string]string
+
+	// Logger receives a debug record for each API request with the method,
+	// URL, status, and duration. Nil disables request logging.
+	Logger *slog.Logger
+
+	// LogBodies adds the request and response bodies to each logged request.
+	// The API token is redacted from everything that is logged.
+	LogBodies bool
 }
 
 // NewClient creates a new PagerDuty client
@@ -41,11 +88,42 @@ func NewClient(cfg Config) *Client {
 		apiHost = DefaultAPIHost
 	}
 
+	eventsHost := cfg.EventsHost
+	if eventsHost == "" {
+		eventsHost = eventsHostForAPIHost(apiHost)
+	}
+
+	httpClient := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	userAgent := cfg.UserAgent
+	if userAgent == "" {
+		userAgent = version.UserAgent()
+	}
+
+	var etags *etagCache
+	if cfg.EnableETagCache {
+		etags = newETagCache()
+	}
+
 	return &Client{
-		apiKey:  cfg.APIKey,
-		apiHost: strings.TrimSuffix(apiHost, "/"),
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+		apiKey:         cfg.APIKey,
+		apiHost:        strings.TrimSuffix(apiHost, "/"),
+		httpClient:     httpClient,
+		requestTimeout: cfg.RequestTimeout,
+		compression:    cfg.EnableCompression,
+		etags:          etags,
+		userAgent:      userAgent,
+		defaultHeaders: cfg.DefaultHeaders,
+		logger:         cfg.Logger,
+		logBodies:      cfg.LogBodies,
+		events: &EventsClient{
+			host:           strings.TrimSuffix(eventsHost, "/"),
+			httpClient:     httpClient,
+			requestTimeout: cfg.RequestTimeout,
+			userAgent:      userAgent,
+			defaultHeaders: cfg.DefaultHeaders,
 		},
 	}
 }
@@ -62,18 +140,82 @@ func NewClientFromEnv() (*Client, error) {
 		apiHost = DefaultAPIHost
 	}
 
+	var requestTimeout time.Duration
+	if v := os.Getenv("PAGERDUTY_REQUEST_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("PAGERDUTY_REQUEST_TIMEOUT must be a duration like '10s', got '%s'", v)
+		}
+		requestTimeout = d
+	}
+
+	var enableCompression bool
+	if v := os.Getenv("PAGERDUTY_ENABLE_COMPRESSION"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("PAGERDUTY_ENABLE_COMPRESSION must be true or false, got '%s'", v)
+		}
+		enableCompression = b
+	}
+
+	var enableETagCache bool
+	if v := os.Getenv("PAGERDUTY_ENABLE_ETAG_CACHE"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("PAGERDUTY_ENABLE_ETAG_CACHE must be true or false, got '%s'", v)
+		}
+		enableETagCache = b
+	}
+
+	var defaultHeaders map[string]string
+	if v := os.Getenv("PAGERDUTY_DEFAULT_HEADERS"); v != "" {
+		if err := json.Unmarshal([]byte(v), &defaultHeaders); err != nil {
+			return nil, fmt.Errorf("PAGERDUTY_DEFAULT_HEADERS must be a JSON object of header names to values: %w", err)
+		}
+	}
+
+	// PAGERDUTY_DEBUG=true logs each request to stderr; "verbose" adds bodies
+	var logger *slog.Logger
+	var logBodies bool
+	if v := os.Getenv("PAGERDUTY_DEBUG"); v != "" {
+		if strings.EqualFold(v, "verbose") {
+			logBodies = true
+		} else if b, err := strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("PAGERDUTY_DEBUG must be true, false, or verbose, got '%s'", v)
+		} else if !b {
+			v = ""
+		}
+		if v != "" {
+			logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		}
+	}
+
 	return NewClient(Config{
-		APIKey:  apiKey,
-		APIHost: apiHost,
+		APIKey:            apiKey,
+		APIHost:           apiHost,
+		EventsHost:        os.Getenv("PAGERDUTY_EVENTS_HOST"),
+		RequestTimeout:    requestTimeout,
+		EnableCompression: enableCompression,
+		EnableETagCache:   enableETagCache,
+		UserAgent:         os.Getenv("PAGERDUTY_USER_AGENT"),
+		DefaultHeaders:    defaultHeaders,
+		Logger:            logger,
+		LogBodies:         logBodies,
 	}), nil
 }
 
-// SetFromEmail sets the From header for requests (used with user tokens)
+// SetFromEmail sets the process-wide default From header for requests (used
+// with user tokens). Per-request identities should be attached to the request
+// context with auth.WithFromEmail instead, which takes precedence.
 func (c *Client) SetFromEmail(email string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.fromEmail = email
 }
 
-// buildURL constructs a full URL with query parameters
+// buildURL constructs a full URL with query parameters. url.Values.Encode
+// sorts by key, so the same params always produce the same URL regardless of
+// map iteration order.
 func (c *Client) buildURL(path string, params map[string]string) string {
 	u := c.apiHost + path
 
@@ -88,7 +230,9 @@ func (c *Client) buildURL(path string, params map[string]string) string {
 	return u
 }
 
-// buildURLWithArrayParams constructs a URL with array query parameters
+// buildURLWithArrayParams constructs a URL with array query parameters. Keys
+// are sorted and each key's values keep their slice order, so the URL is
+// deterministic.
 func (c *Client) buildURLWithArrayParams(path string, params map[string][]string) string {
 	u := c.apiHost + path
 
@@ -115,6 +259,18 @@ func (c *Client) getAPIKey(ctx context.Context) string {
 	return c.apiKey
 }
 
+// getFromEmail returns the From email to use, checking context for override
+func (c *Client) getFromEmail(ctx context.Context) string {
+	if ctx != nil {
+		if email, ok := auth.GetFromEmail(ctx); ok && email != "" {
+			return email
+		}
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.fromEmail
+}
+
 // doRequest performs an HTTP request with proper headers
 func (c *Client) doRequest(method, url string, body interface{}) ([]byte, error) {
 	return c.doRequestWithContext(context.Background(), method, url, body)
@@ -122,46 +278,172 @@ func (c *Client) doRequest(method, url string, body interface{}) ([]byte, error)
 
 // doRequestWithContext performs an HTTP request with proper headers and context support
 func (c *Client) doRequestWithContext(ctx context.Context, method, url string, body interface{}) ([]byte, error) {
+	if isWriteMethod(method) && IsDryRun(ctx) {
+		return nil, &DryRunError{Method: method, Path: strings.TrimPrefix(url, c.apiHost), Body: body}
+	}
+
+	if c.logger == nil {
+		respBody, _, err := c.sendRequest(ctx, method, url, body)
+		return respBody, err
+	}
+
+	start := time.Now()
+	respBody, status, err := c.sendRequest(ctx, method, url, body)
+	c.logRequest(ctx, method, url, body, respBody, status, time.Since(start), err)
+	return respBody, err
+}
+
+// sendRequest sends the request and returns the response body and HTTP status.
+// The status is zero when no response was received.
+func (c *Client) sendRequest(ctx context.Context, method, url string, body interface{}) ([]byte, int, error) {
+	if c.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+		defer cancel()
+	}
+
 	var reqBody io.Reader
 
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, 0, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Token token="+c.getAPIKey(ctx))
+	// Default headers go first so they cannot replace the headers below
+	setHeaders(req, c.defaultHeaders)
+
+	apiKey := c.getAPIKey(ctx)
+	req.Header.Set("Authorization", "Token token="+apiKey)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/vnd.pagerduty+json;version=2")
-	req.Header.Set("User-Agent", UserAgent)
+	req.Header.Set("User-Agent", c.userAgent)
+
+	// Setting Accept-Encoding ourselves turns off the transport's transparent
+	// decompression, so the body is decoded in readResponseBody
+	if c.compression {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+
+	if from := c.getFromEmail(ctx); from != "" {
+		req.Header.Set("From", from)
+	}
 
-	if c.fromEmail != "" {
-		req.Header.Set("From", c.fromEmail)
+	// Revalidate a previously cached GET response instead of downloading it again
+	var cacheKey string
+	var cached etagEntry
+	var hasCached bool
+	if c.etags != nil && method == http.MethodGet {
+		cacheKey = etagCacheKey(apiKey, url)
+		if cached, hasCached = c.etags.get(cacheKey); hasCached {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		if isTimeout(err) {
+			return nil, 0, &TimeoutError{Err: err}
+		}
+		return nil, 0, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	respBody, err := readResponseBody(resp)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		if isTimeout(err) {
+			return nil, resp.StatusCode, &TimeoutError{Err: err}
+		}
+		return nil, resp.StatusCode, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotModified && hasCached {
+		return cached.body, resp.StatusCode, nil
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+		return nil, resp.StatusCode, &APIError{StatusCode: resp.StatusCode, Body: respBody}
+	}
+
+	if cacheKey != "" {
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			c.etags.put(cacheKey, etag, respBody)
+		}
+	}
+
+	return respBody, resp.StatusCode, nil
+}
+
+// maxLoggedBodyBytes caps how much of each body is written to the debug log
+const maxLoggedBodyBytes = 4096
+
+// logRequest writes a debug record for a completed request. The API token is
+// redacted from the URL and bodies before anything is logged.
+func (c *Client) logRequest(ctx context.Context, method, url string, reqBody interface{}, respBody []byte, status int, duration time.Duration, err error) {
+	redact := strings.NewReplacer()
+	if apiKey := c.getAPIKey(ctx); apiKey != "" {
+		redact = strings.NewReplacer(apiKey, "[REDACTED]")
 	}
 
-	return respBody, nil
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("url", redact.Replace(url)),
+		slog.Int("status", status),
+		slog.Duration("duration", duration),
+	}
+	if id, ok := RequestID(ctx); ok {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", redact.Replace(err.Error())))
+	}
+	if c.logBodies {
+		if reqBody != nil {
+			if b, marshalErr := json.Marshal(reqBody); marshalErr == nil {
+				attrs = append(attrs, slog.String("request_body", truncateForLog(redact.Replace(string(b)))))
+			}
+		}
+		if respBody != nil {
+			attrs = append(attrs, slog.String("response_body", truncateForLog(redact.Replace(string(respBody)))))
+		}
+	}
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "pagerduty request", attrs...)
+}
+
+// truncateForLog shortens s to maxLoggedBodyBytes
+func truncateForLog(s string) string {
+	if len(s) <= maxLoggedBodyBytes {
+		return s
+	}
+	return s[:maxLoggedBodyBytes] + "...(truncated)"
+}
+
+// setHeaders sets each of headers on the request
+func setHeaders(req *http.Request, headers map[string]string) {
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+}
+
+// readResponseBody reads the response body, decompressing it when the server
+// sent it gzip-encoded
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return io.ReadAll(resp.Body)
+	}
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	return io.ReadAll(gz)
 }
 
 // Get performs a GET request
@@ -188,6 +470,12 @@ func (c *Client) Put(path string, body interface{}) ([]byte, error) {
 	return c.doRequest(http.MethodPut, url, body)
 }
 
+// Patch performs a PATCH request
+func (c *Client) Patch(path string, body interface{}) ([]byte, error) {
+	url := c.buildURL(path, nil)
+	return c.doRequest(http.MethodPatch, url, body)
+}
+
 // Delete performs a DELETE request
 func (c *Client) Delete(path string) ([]byte, error) {
 	url := c.buildURL(path, nil)
@@ -203,6 +491,15 @@ func (c *Client) GetJSON(path string, params map[string]string, v interface{}) e
 	return json.Unmarshal(data, v)
 }
 
+// GetJSONWithArrayParams performs a GET request with array parameters and unmarshals the response
+func (c *Client) GetJSONWithArrayParams(path string, params map[string][]string, v interface{}) error {
+	data, err := c.GetWithArrayParams(path, params)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
 // PostJSON performs a POST request and unmarshals the response
 func (c *Client) PostJSON(path string, body interface{}, v interface{}) error {
 	data, err := c.Post(path, body)
@@ -221,6 +518,15 @@ func (c *Client) PutJSON(path string, body interface{}, v interface{}) error {
 	return json.Unmarshal(data, v)
 }
 
+// PatchJSON performs a PATCH request and unmarshals the response
+func (c *Client) PatchJSON(path string, body interface{}, v interface{}) error {
+	data, err := c.Patch(path, body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
 // PaginatedResponse represents a paginated API response
 type PaginatedResponse struct {
 	Offset int  `json:"offset"`
@@ -304,6 +610,12 @@ func (c *Client) PutWithContext(ctx context.Context, path string, body interface
 	return c.doRequestWithContext(ctx, http.MethodPut, url, body)
 }
 
+// PatchWithContext performs a PATCH request with context support
+func (c *Client) PatchWithContext(ctx context.Context, path string, body interface{}) ([]byte, error) {
+	url := c.buildURL(path, nil)
+	return c.doRequestWithContext(ctx, http.MethodPatch, url, body)
+}
+
 // DeleteWithContext performs a DELETE request with context support
 func (c *Client) DeleteWithContext(ctx context.Context, path string) ([]byte, error) {
 	url := c.buildURL(path, nil)
@@ -319,6 +631,15 @@ func (c *Client) GetJSONWithContext(ctx context.Context, path string, params map
 	return json.Unmarshal(data, v)
 }
 
+// GetJSONWithArrayParamsContext performs a GET request with array parameters and unmarshals the response with context support
+func (c *Client) GetJSONWithArrayParamsContext(ctx context.Context, path string, params map[string][]string, v interface{}) error {
+	data, err := c.GetWithArrayParamsContext(ctx, path, params)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
 // PostJSONWithContext performs a POST request and unmarshals the response with context support
 func (c *Client) PostJSONWithContext(ctx context.Context, path string, body interface{}, v interface{}) error {
 	data, err := c.PostWithContext(ctx, path, body)
@@ -336,3 +657,19 @@ func (c *Client) PutJSONWithContext(ctx context.Context, path string, body inter
 	}
 	return json.Unmarshal(data, v)
 }
+
+// PatchJSONWithContext performs a PATCH request and unmarshals the response with context support
+func (c *Client) PatchJSONWithContext(ctx context.Context, path string, body interface{}, v interface{}) error {
+	data, err := c.PatchWithContext(ctx, path, body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Ping verifies that the PagerDuty API is reachable and the configured token is
+// valid using a lightweight authenticated request
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.GetWithContext(ctx, "/abilities", nil)
+	return err
+}
@@ -0,0 +1,345 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/auth"
+)
+
+// resolveAPIKey sends a request through the auth middleware and returns the API key the client would use
+func resolveAPIKey(t *testing.T, c *Client, authorizer auth.Authorizer, headers map[string]string) string {
+	t.Helper()
+
+	var apiKey string
+	handler := auth.Middleware(authorizer)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiKey = c.getAPIKey(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d. Body: %s", rec.Code, rec.Body.String())
+	}
+	return apiKey
+}
+
+// TestGetAPIKey_MappedToken tests that a bearer token mapped in the token store reaches getAPIKey
+func TestGetAPIKey_MappedToken(t *testing.T) {
+	c := NewClient(Config{APIKey: "env-api-key"})
+	authorizer := &auth.TokenMappingAuthorizer{
+		Authorizer: &auth.MockAuthorizer{},
+		Store:      auth.StaticTokenStore{"user-bearer": "pd-user-token"},
+	}
+
+	apiKey := resolveAPIKey(t, c, authorizer, map[string]string{
+		"Authorization":     "Bearer user-bearer",
+		"X-PagerDuty-Token": "header-token",
+	})
+
+	if apiKey != "pd-user-token" {
+		t.Errorf("Expected API key 'pd-user-token', got '%s'", apiKey)
+	}
+}
+
+// TestGetAPIKey_HeaderFallback tests that X-PagerDuty-Token is used when the bearer token is not mapped
+func TestGetAPIKey_HeaderFallback(t *testing.T) {
+	c := NewClient(Config{APIKey: "env-api-key"})
+	authorizer := &auth.TokenMappingAuthorizer{
+		Authorizer: &auth.MockAuthorizer{},
+		Store:      auth.StaticTokenStore{"other-bearer": "pd-other-token"},
+	}
+
+	apiKey := resolveAPIKey(t, c, authorizer, map[string]string{
+		"Authorization":     "Bearer user-bearer",
+		"X-PagerDuty-Token": "header-token",
+	})
+
+	if apiKey != "header-token" {
+		t.Errorf("Expected API key 'header-token', got '%s'", apiKey)
+	}
+}
+
+// TestGetAPIKey_Default tests that the configured API key is used when no per-request token is present
+func TestGetAPIKey_Default(t *testing.T) {
+	c := NewClient(Config{APIKey: "env-api-key"})
+
+	apiKey := resolveAPIKey(t, c, &auth.MockAuthorizer{}, map[string]string{
+		"Authorization": "Bearer user-bearer",
+	})
+
+	if apiKey != "env-api-key" {
+		t.Errorf("Expected API key 'env-api-key', got '%s'", apiKey)
+	}
+}
+
+// TestDoRequest_ConcurrentIdentity tests that per-request From emails and tokens don't leak between concurrent requests
+func TestDoRequest_ConcurrentIdentity(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Echo the identity headers so each caller can verify what was sent
+		fmt.Fprintf(w, "%s|%s", r.Header.Get("Authorization"), r.Header.Get("From"))
+	}))
+	defer ts.Close()
+
+	c := NewClient(Config{APIKey: "env-api-key", APIHost: ts.URL})
+
+	const workers = 20
+	const iterations = 25
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*iterations)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			token := fmt.Sprintf("token-%d", i)
+			email := fmt.Sprintf("user%d@example.com", i)
+			ctx := context.WithValue(context.Background(), auth.PagerDutyTokenKey, token)
+			ctx = auth.WithFromEmail(ctx, email)
+
+			for j := 0; j < iterations; j++ {
+				// Mutate the process-wide default concurrently with requests
+				c.SetFromEmail(fmt.Sprintf("default%d@example.com", j))
+
+				data, err := c.GetWithContext(ctx, "/users/me", nil)
+				if err != nil {
+					errs <- err
+					return
+				}
+				want := fmt.Sprintf("Token token=%s|%s", token, email)
+				if string(data) != want {
+					errs <- fmt.Errorf("expected '%s', got '%s'", want, string(data))
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+// TestRequestTimeout tests that a slow PagerDuty response fails with a TimeoutError once RequestTimeout elapses
+func TestRequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	c := NewClient(Config{APIKey: "test-api-key", APIHost: ts.URL, RequestTimeout: 50 * time.Millisecond})
+
+	start := time.Now()
+	_, err := c.GetWithContext(context.Background(), "/incidents", nil)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Expected the request to be cut off near the timeout, took %s", elapsed)
+	}
+
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("Expected a TimeoutError, got %v", err)
+	}
+	if err.Error() != "PagerDuty request timed out" {
+		t.Errorf("Expected timeout message, got '%s'", err.Error())
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the error to wrap context.DeadlineExceeded, got %v", err)
+	}
+}
+
+// TestEnableCompression tests that gzipped bodies are decoded with and without EnableCompression
+func TestEnableCompression(t *testing.T) {
+	const body = `{"incidents":[{"id":"PINC1"}]}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			w.Write([]byte(body))
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(body))
+		gz.Close()
+	}))
+	defer ts.Close()
+
+	for _, enabled := range []bool{true, false} {
+		c := NewClient(Config{APIKey: "test-api-key", APIHost: ts.URL, EnableCompression: enabled})
+		data, err := c.GetWithContext(context.Background(), "/incidents", nil)
+		if err != nil {
+			t.Fatalf("EnableCompression=%v: unexpected error: %v", enabled, err)
+		}
+		if string(data) != body {
+			t.Errorf("EnableCompression=%v: expected decoded body %s, got %q", enabled, body, data)
+		}
+	}
+}
+
+// TestPatchJSON tests that PATCH requests send the body and decode the response
+func TestPatchJSON(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH, got %s", r.Method)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"value":"blue"}` {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.Write([]byte(`{"value":"blue"}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient(Config{APIKey: "test-api-key", APIHost: ts.URL})
+	var out struct {
+		Value string `json:"value"`
+	}
+	if err := c.PatchJSONWithContext(context.Background(), "/incidents/PINC1/custom_fields/values", map[string]string{"value": "blue"}, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if out.Value != "blue" {
+		t.Errorf("Expected value 'blue', got '%s'", out.Value)
+	}
+
+	if _, err := c.PatchWithContext(WithDryRun(context.Background()), "/incidents/PINC1", nil); err == nil {
+		t.Error("Expected PATCH to be skipped in a dry run")
+	}
+}
+
+// TestBuildURL_Deterministic tests that query strings are sorted by key and stable across builds
+func TestBuildURL_Deterministic(t *testing.T) {
+	c := NewClient(Config{APIKey: "test-api-key", APIHost: "https://api.example.com"})
+
+	params := map[string]string{"until": "b", "since": "a", "limit": "100", "offset": "0", "time_zone": "UTC"}
+	want := "https://api.example.com/incidents?limit=100&offset=0&since=a&time_zone=UTC&until=b"
+	arrayParams := map[string][]string{"statuses[]": {"triggered", "acknowledged"}, "service_ids[]": {"PSVC2", "PSVC1"}, "limit": {"100"}}
+	wantArray := "https://api.example.com/incidents?limit=100&service_ids%5B%5D=PSVC2&service_ids%5B%5D=PSVC1&statuses%5B%5D=triggered&statuses%5B%5D=acknowledged"
+
+	for i := 0; i < 50; i++ {
+		if got := c.buildURL("/incidents", params); got != want {
+			t.Fatalf("buildURL = %s, want %s", got, want)
+		}
+		if got := c.buildURLWithArrayParams("/incidents", arrayParams); got != wantArray {
+			t.Fatalf("buildURLWithArrayParams = %s, want %s", got, wantArray)
+		}
+	}
+}
+
+// TestETagCache tests that a 304 response is served from the cache and tokens do not share entries
+func TestETagCache(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"services":[{"id":"PSVC1"}]}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient(Config{APIKey: "test-api-key", APIHost: ts.URL, EnableETagCache: true})
+	for i := 0; i < 2; i++ {
+		data, err := c.GetWithContext(context.Background(), "/services", nil)
+		if err != nil {
+			t.Fatalf("Request %d: unexpected error: %v", i, err)
+		}
+		if string(data) != `{"services":[{"id":"PSVC1"}]}` {
+			t.Errorf("Request %d: expected the cached body, got %q", i, data)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+
+	key := etagCacheKey("other-api-key", ts.URL+"/services")
+	if _, ok := c.etags.get(key); ok {
+		t.Error("Expected no cache entry for a different API key")
+	}
+}
+
+// TestDefaultHeaders tests that custom headers and User-Agent are sent without replacing the auth and accept headers
+func TestDefaultHeaders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := map[string]string{
+			"X-Tenant-ID":   "acme",
+			"User-Agent":    "acme-bot/1.0",
+			"Authorization": "Token token=test-api-key",
+			"Accept":        "application/vnd.pagerduty+json;version=2",
+		}
+		for k, v := range want {
+			if got := r.Header.Get(k); got != v {
+				t.Errorf("Expected %s '%s', got '%s'", k, v, got)
+			}
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient(Config{
+		APIKey:    "test-api-key",
+		APIHost:   ts.URL,
+		UserAgent: "acme-bot/1.0",
+		DefaultHeaders: map[string]string{
+			"X-Tenant-ID":   "acme",
+			"Authorization": "Token token=spoofed",
+			"Accept":        "text/html",
+		},
+	})
+	if _, err := c.GetWithContext(context.Background(), "/users/me", nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+// TestRequestLogging tests that a configured logger records each request with
+// its bodies when LogBodies is set, and never records the API token
+func TestRequestLogging(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"echo":"test-api-key"}`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	c := NewClient(Config{
+		APIKey:    "test-api-key",
+		APIHost:   ts.URL,
+		Logger:    slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		LogBodies: true,
+	})
+	var resp map[string]string
+	if err := c.PostJSONWithContext(context.Background(), "/incidents", map[string]string{"title": "disk full"}, &resp); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	entry := buf.String()
+	for _, want := range []string{"method=POST", "/incidents", "status=200", "duration=", "disk full", "response_body"} {
+		if !strings.Contains(entry, want) {
+			t.Errorf("Expected log entry to contain %q, got %s", want, entry)
+		}
+	}
+	if strings.Contains(entry, "test-api-key") {
+		t.Errorf("Expected the API token to be redacted, got %s", entry)
+	}
+}
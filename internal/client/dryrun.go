@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// dryRunKey is the context key marking a request as a dry run
+type dryRunKey struct{}
+
+// WithDryRun returns a context in which write requests (POST, PUT, PATCH,
+// DELETE) are not sent. Reads still go to PagerDuty so handlers can validate
+// and build the payload as normal.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun reports whether ctx was marked with WithDryRun
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
+
+// DryRunError is returned in place of sending a write request in dry-run mode.
+// It carries the request that would have been sent.
+type DryRunError struct {
+	Method string
+	Path   string
+	Body   any
+}
+
+// Error implements the error interface
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry run: %s %s was not sent", e.Method, e.Path)
+}
+
+// isWriteMethod reports whether the HTTP method changes PagerDuty state
+func isWriteMethod(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead
+}
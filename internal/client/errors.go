@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// APIError is returned when the PagerDuty API responds with an error status
+type APIError struct {
+	StatusCode int
+	Body       []byte
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, string(e.Body))
+}
+
+// Detail returns the "error" object from a PagerDuty error response, or the
+// raw body wrapped in a message field when the body is not a PagerDuty error
+func (e *APIError) Detail() any {
+	var doc struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(e.Body, &doc); err == nil && len(doc.Error) > 0 {
+		return doc.Error
+	}
+	return map[string]string{"message": string(e.Body)}
+}
+
+// TimeoutError is returned when a PagerDuty request does not complete before
+// its deadline, whether from Config.RequestTimeout, the caller's context, or
+// the HTTP client timeout
+type TimeoutError struct {
+	Err error
+}
+
+// Error implements the error interface
+func (e *TimeoutError) Error() string {
+	return "PagerDuty request timed out"
+}
+
+// Unwrap returns the underlying transport or context error
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// isTimeout reports whether a transport error was caused by a deadline
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+)
+
+// maxETagEntries bounds the number of responses kept by the ETag cache
+const maxETagEntries = 1000
+
+// etagEntry is a cached response body and the ETag it was served with
+type etagEntry struct {
+	etag string
+	body []byte
+}
+
+// etagCache stores GET response bodies by request so they can be revalidated
+// with If-None-Match. Entries are keyed by API token as well as URL so callers
+// with different tokens never share cached responses. When full, the oldest
+// entry is evicted.
+type etagCache struct {
+	mu      sync.Mutex
+	entries map[string]etagEntry
+	order   []string
+}
+
+// newETagCache creates an empty ETag cache
+func newETagCache() *etagCache {
+	return &etagCache{entries: make(map[string]etagEntry)}
+}
+
+// etagCacheKey builds the cache key for a request made with apiKey
+func etagCacheKey(apiKey, url string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:]) + " " + url
+}
+
+// get returns the cached entry for key
+func (c *etagCache) get(key string) (etagEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+// put stores the response body served with etag for key
+func (c *etagCache) put(key, etag string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		if len(c.order) >= maxETagEntries {
+			delete(c.entries, c.order[0])
+			c.order = c.order[1:]
+		}
+		c.order = append(c.order, key)
+	}
+	c.entries[key] = etagEntry{etag: etag, body: body}
+}
@@ -0,0 +1,211 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultEventsHost = "https://events.pagerduty.com"
+	euEventsHost      = "https://events.eu.pagerduty.com"
+)
+
+// Events API v2 paths
+const (
+	alertEnqueuePath  = "/v2/enqueue"
+	changeEnqueuePath = "/v2/change/enqueue"
+)
+
+// EventsClient sends events to the PagerDuty Events API v2. Unlike the REST
+// API, events are authenticated by the routing key in each event rather than
+// by an API token, so no Authorization header is sent.
+type EventsClient struct {
+	host           string
+	httpClient     *http.Client
+	requestTimeout time.Duration
+	userAgent      string
+	defaultHeaders map[string]string
+}
+
+// eventsHostForAPIHost returns the Events API host in the same region as the
+// REST API host, so EU accounts send events to the EU endpoint
+func eventsHostForAPIHost(apiHost string) string {
+	if u, err := url.Parse(apiHost); err == nil && strings.EqualFold(u.Hostname(), "api.eu.pagerduty.com") {
+		return euEventsHost
+	}
+	return DefaultEventsHost
+}
+
+// Events returns the Events API client. It shares the REST client's HTTP
+// client, request timeout, and region.
+func (c *Client) Events() *EventsClient {
+	return c.events
+}
+
+// AlertEvent is an Events API v2 alert event that triggers, acknowledges, or
+// resolves an alert
+type AlertEvent struct {
+	RoutingKey  string        `json:"routing_key"`
+	EventAction string        `json:"event_action"` // trigger, acknowledge, resolve
+	DedupKey    string        `json:"dedup_key,omitempty"`
+	Payload     *AlertPayload `json:"payload,omitempty"`
+	Client      string        `json:"client,omitempty"`
+	ClientURL   string        `json:"client_url,omitempty"`
+	Links       []EventLink   `json:"links,omitempty"`
+	Images      []EventImage  `json:"images,omitempty"`
+}
+
+// AlertPayload describes the alert for a trigger event
+type AlertPayload struct {
+	Summary       string         `json:"summary"`
+	Source        string         `json:"source"`
+	Severity      string         `json:"severity"` // critical, error, warning, info
+	Timestamp     string         `json:"timestamp,omitempty"`
+	Component     string         `json:"component,omitempty"`
+	Group         string         `json:"group,omitempty"`
+	Class         string         `json:"class,omitempty"`
+	CustomDetails map[string]any `json:"custom_details,omitempty"`
+}
+
+// ChangeEvent is an Events API v2 change event, such as a deploy
+type ChangeEvent struct {
+	RoutingKey string        `json:"routing_key"`
+	Payload    ChangePayload `json:"payload"`
+	Links      []EventLink   `json:"links,omitempty"`
+	Images     []EventImage  `json:"images,omitempty"`
+}
+
+// ChangePayload describes the change
+type ChangePayload struct {
+	Summary       string         `json:"summary"`
+	Source        string         `json:"source,omitempty"`
+	Timestamp     string         `json:"timestamp,omitempty"`
+	CustomDetails map[string]any `json:"custom_details,omitempty"`
+}
+
+// EventLink is a link attached to an event
+type EventLink struct {
+	Href string `json:"href"`
+	Text string `json:"text,omitempty"`
+}
+
+// EventImage is an image attached to an event
+type EventImage struct {
+	Src  string `json:"src"`
+	Href string `json:"href,omitempty"`
+	Alt  string `json:"alt,omitempty"`
+}
+
+// EventResponse is the Events API response to an enqueued event
+type EventResponse struct {
+	Status   string   `json:"status"`
+	Message  string   `json:"message"`
+	DedupKey string   `json:"dedup_key,omitempty"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// alertSeverities are the severities accepted in an alert payload
+var alertSeverities = map[string]bool{"critical": true, "error": true, "warning": true, "info": true}
+
+// validate checks the fields the Events API requires for the event action
+func (e *AlertEvent) validate() error {
+	if e.RoutingKey == "" {
+		return fmt.Errorf("routing_key is required")
+	}
+	switch e.EventAction {
+	case "trigger":
+		if e.Payload == nil || e.Payload.Summary == "" || e.Payload.Source == "" {
+			return fmt.Errorf("trigger events require a payload with summary and source")
+		}
+		if !alertSeverities[e.Payload.Severity] {
+			return fmt.Errorf("invalid severity '%s': must be critical, error, warning, or info", e.Payload.Severity)
+		}
+	case "acknowledge", "resolve":
+		if e.DedupKey == "" {
+			return fmt.Errorf("%s events require dedup_key", e.EventAction)
+		}
+	default:
+		return fmt.Errorf("invalid event_action '%s': must be trigger, acknowledge, or resolve", e.EventAction)
+	}
+	return nil
+}
+
+// EnqueueAlert sends an alert event. For trigger events the response carries
+// the dedup key to use when acknowledging or resolving the alert.
+func (c *EventsClient) EnqueueAlert(ctx context.Context, event AlertEvent) (*EventResponse, error) {
+	if err := event.validate(); err != nil {
+		return nil, err
+	}
+	return c.enqueue(ctx, alertEnqueuePath, event)
+}
+
+// EnqueueChange sends a change event
+func (c *EventsClient) EnqueueChange(ctx context.Context, event ChangeEvent) (*EventResponse, error) {
+	if event.RoutingKey == "" {
+		return nil, fmt.Errorf("routing_key is required")
+	}
+	if event.Payload.Summary == "" {
+		return nil, fmt.Errorf("change events require a payload summary")
+	}
+	return c.enqueue(ctx, changeEnqueuePath, event)
+}
+
+// enqueue posts an event to the Events API
+func (c *EventsClient) enqueue(ctx context.Context, path string, event any) (*EventResponse, error) {
+	if IsDryRun(ctx) {
+		return nil, &DryRunError{Method: http.MethodPost, Path: path, Body: event}
+	}
+
+	if c.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+		defer cancel()
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.host+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	setHeaders(req, c.defaultHeaders)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if isTimeout(err) {
+			return nil, &TimeoutError{Err: err}
+		}
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		if isTimeout(err) {
+			return nil, &TimeoutError{Err: err}
+		}
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: respBody}
+	}
+
+	var result EventResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &result, nil
+}
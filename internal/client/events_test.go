@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestEventsClient starts a fake Events API and returns an events client pointed at it
+func newTestEventsClient(t *testing.T, handler http.HandlerFunc) *EventsClient {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return NewClient(Config{APIKey: "test-api-key", EventsHost: ts.URL}).Events()
+}
+
+// TestEnqueueAlert tests that alert events are posted without a token and the dedup key is returned
+func TestEnqueueAlert(t *testing.T) {
+	c := newTestEventsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v2/enqueue" {
+			t.Errorf("Expected POST /v2/enqueue, got %s %s", r.Method, r.URL.Path)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("Expected no Authorization header, got '%s'", auth)
+		}
+		var event AlertEvent
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Errorf("Failed to parse event: %v", err)
+		}
+		if event.RoutingKey != "R0UT1NGKEY" || event.Payload == nil || event.Payload.Severity != "critical" {
+			t.Errorf("Unexpected event %+v", event)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status":"success","message":"Event processed","dedup_key":"abc123"}`))
+	})
+
+	resp, err := c.EnqueueAlert(context.Background(), AlertEvent{
+		RoutingKey:  "R0UT1NGKEY",
+		EventAction: "trigger",
+		Payload:     &AlertPayload{Summary: "Disk full", Source: "db-1", Severity: "critical"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.DedupKey != "abc123" {
+		t.Errorf("Expected dedup_key 'abc123', got '%s'", resp.DedupKey)
+	}
+}
+
+// TestEnqueueChange tests that change events are posted to the change endpoint
+func TestEnqueueChange(t *testing.T) {
+	c := newTestEventsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/change/enqueue" {
+			t.Errorf("Expected /v2/change/enqueue, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status":"success","message":"Change event processed"}`))
+	})
+
+	resp, err := c.EnqueueChange(context.Background(), ChangeEvent{
+		RoutingKey: "R0UT1NGKEY",
+		Payload:    ChangePayload{Summary: "Deployed v1.2.3", Source: "ci"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.Status != "success" {
+		t.Errorf("Expected status 'success', got '%s'", resp.Status)
+	}
+}
+
+// TestEnqueueAlert_InvalidEvent tests that invalid events are rejected without a request and API errors are returned
+func TestEnqueueAlert_InvalidEvent(t *testing.T) {
+	var requests int
+	c := newTestEventsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":"invalid event","message":"Event object is invalid","errors":["'routing_key' is invalid"]}`))
+	})
+
+	invalid := []AlertEvent{
+		{EventAction: "trigger", Payload: &AlertPayload{Summary: "s", Source: "x", Severity: "info"}},
+		{RoutingKey: "K", EventAction: "trigger", Payload: &AlertPayload{Summary: "s", Source: "x", Severity: "sev1"}},
+		{RoutingKey: "K", EventAction: "resolve"},
+		{RoutingKey: "K", EventAction: "escalate"},
+	}
+	for _, event := range invalid {
+		if _, err := c.EnqueueAlert(context.Background(), event); err == nil {
+			t.Errorf("Expected a validation error for %+v", event)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("Expected no requests for invalid events, got %d", requests)
+	}
+
+	_, err := c.EnqueueAlert(context.Background(), AlertEvent{RoutingKey: "BAD", EventAction: "resolve", DedupKey: "abc"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected a 400 APIError, got %v", err)
+	}
+}
+
+// TestEventsHostForAPIHost tests that the events host follows the API host's region
+func TestEventsHostForAPIHost(t *testing.T) {
+	tests := map[string]string{
+		"https://api.pagerduty.com":     DefaultEventsHost,
+		"https://api.eu.pagerduty.com":  "https://events.eu.pagerduty.com",
+		"https://api.eu.pagerduty.com/": "https://events.eu.pagerduty.com",
+		"http://localhost:8080":         DefaultEventsHost,
+	}
+	for apiHost, want := range tests {
+		if got := eventsHostForAPIHost(apiHost); got != want {
+			t.Errorf("eventsHostForAPIHost(%q) = %q, want %q", apiHost, got, want)
+		}
+	}
+}
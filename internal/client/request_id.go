@@ -0,0 +1,18 @@
+package client
+
+import "context"
+
+// requestIDKey is the context key carrying the inbound request ID
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying the ID of the inbound request that
+// triggered the API calls, so they can be correlated in logs
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID attached with WithRequestID, if any
+func RequestID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
@@ -0,0 +1,32 @@
+package models
+
+// Addon represents an add-on that embeds an external page in the PagerDuty UI
+type Addon struct {
+	ID       string             `json:"id,omitempty"`
+	Type     string             `json:"type"` // full_page_addon, incident_show_addon
+	Summary  string             `json:"summary,omitempty"`
+	Self     string             `json:"self,omitempty"`
+	HTMLURL  string             `json:"html_url,omitempty"`
+	Name     string             `json:"name"`
+	Src      string             `json:"src"`
+	Services []ServiceReference `json:"services,omitempty"`
+}
+
+// AddonRequest represents a request to install or update an add-on
+type AddonRequest struct {
+	Addon Addon `json:"addon"`
+}
+
+// AddonResponse is the API response wrapper for a single add-on
+type AddonResponse struct {
+	Addon Addon `json:"addon"`
+}
+
+// AddonsResponse is the API response wrapper for multiple add-ons
+type AddonsResponse struct {
+	Addons []Addon `json:"addons"`
+	Offset int     `json:"offset"`
+	Limit  int     `json:"limit"`
+	More   bool    `json:"more"`
+	Total  int     `json:"total"`
+}
@@ -11,13 +11,75 @@ const (
 // ListResponse is a generic response wrapper for list operations
 type ListResponse[T any] struct {
 	Response []T `json:"response"`
+
+	// More reports whether PagerDuty has further records after this page
+	More bool `json:"more"`
+
+	// Total is the total number of matching records, when PagerDuty reports it
+	Total int `json:"total,omitempty"`
+
+	// NextOffset is the offset to request for the next page when More is set
+	NextOffset int `json:"next_offset,omitempty"`
+
+	// paged records whether More came from PagerDuty rather than defaulting to false
+	paged bool
+
+	// offsetPaging records whether the tool accepts an offset, so NextOffset can be followed
+	offsetPaging bool
+}
+
+// WithPage returns the list with the pagination fields of the PagerDuty
+// response it was read from, for tools that accept an offset argument. offset
+// is the offset of this page.
+func (r ListResponse[T]) WithPage(offset int, more bool, total int) ListResponse[T] {
+	r.paged = true
+	r.offsetPaging = true
+	r.More = more
+	r.Total = total
+	if more {
+		r.NextOffset = offset + len(r.Response)
+	}
+	return r
+}
+
+// WithSourcePage is WithPage for a list derived from a PagerDuty page rather
+// than copied from it, such as grouped or filtered records. read is the number
+// of records the page held, so the next offset follows exactly those. The
+// PagerDuty total counts source records rather than items in this list, so it
+// is left out.
+func (r ListResponse[T]) WithSourcePage(offset, read int, more bool) ListResponse[T] {
+	r.paged = true
+	r.offsetPaging = true
+	r.More = more
+	if more {
+		r.NextOffset = offset + read
+	}
+	return r
+}
+
+// WithMore returns the list with the more and total fields of the PagerDuty
+// response it was read from, for tools without an offset argument. No next
+// offset is given since the caller has no way to request it.
+func (r ListResponse[T]) WithMore(more bool, total int) ListResponse[T] {
+	r.paged = true
+	r.More = more
+	r.Total = total
+	return r
 }
 
 // Summary returns a summary of the list response
 func (r *ListResponse[T]) Summary() string {
 	count := len(r.Response)
 	summary := fmt.Sprintf("Returned %d record(s)", count)
-	if count == MaxResults {
+	if r.Total > count {
+		summary += fmt.Sprintf(" of %d", r.Total)
+	}
+	switch {
+	case r.More && r.offsetPaging:
+		summary += fmt.Sprintf(". More records are available; request offset %d for the next page.", r.NextOffset)
+	case r.More:
+		summary += ". More records are available; narrow the filters to see them."
+	case !r.paged && (count == MaxResults || count == MaxPaginationLimit):
 		summary += ". WARNING: The number of records equals the response limit. There may be more records not included in this response."
 	}
 	return summary
@@ -5,12 +5,12 @@ import "fmt"
 // ChangeEvent represents a PagerDuty change event
 type ChangeEvent struct {
 	ID             string             `json:"id,omitempty"`
+	Timestamp      PDTime             `json:"timestamp,omitzero"`
 	Type           string             `json:"type,omitempty"`
 	Self           string             `json:"self,omitempty"`
 	Summary        string             `json:"summary,omitempty"`
 	Source         string             `json:"source,omitempty"`
 	RoutingKey     string             `json:"routing_key,omitempty"`
-	Timestamp      string             `json:"timestamp,omitempty"`
 	Integration    *IntegrationReference `json:"integration,omitempty"`
 	Services       []ServiceReference `json:"services,omitempty"`
 	Links          []ChangeEventLink  `json:"links,omitempty"`
@@ -87,3 +87,20 @@ type ChangeEventsResponse struct {
 	More         bool          `json:"more"`
 	Total        int           `json:"total"`
 }
+
+// ServiceChangeTimeline is the change events for one service in time order
+type ServiceChangeTimeline struct {
+	Service      ServiceReference      `json:"service"`
+	Count        int                   `json:"count"`
+	FirstChange  PDTime                `json:"first_change,omitzero"`
+	LastChange   PDTime                `json:"last_change,omitzero"`
+	ChangeEvents []ChangeTimelineEntry `json:"change_events"`
+}
+
+// ChangeTimelineEntry is a compact change event within a service timeline
+type ChangeTimelineEntry struct {
+	ID        string `json:"id"`
+	Timestamp PDTime `json:"timestamp,omitzero"`
+	Summary   string `json:"summary,omitempty"`
+	Source    string `json:"source,omitempty"`
+}
@@ -27,8 +27,46 @@ type EscalationRule struct {
 
 // EscalationTarget represents a target in an escalation rule
 type EscalationTarget struct {
-	ID   string `json:"id"`
-	Type string `json:"type"` // user_reference, schedule_reference
+	ID      string `json:"id"`
+	Type    string `json:"type"` // user_reference, schedule_reference
+	Summary string `json:"summary,omitempty"`
+}
+
+// EscalationPolicyUpdateRequest represents a request to update an escalation policy
+type EscalationPolicyUpdateRequest struct {
+	EscalationPolicy EscalationPolicy `json:"escalation_policy"`
+}
+
+// EscalationPathStep represents who would be paged at one level of an escalation policy
+type EscalationPathStep struct {
+	Level              int                  `json:"level"`
+	DelayMinutes       int                  `json:"delay_minutes"`
+	StartsAfterMinutes int                  `json:"starts_after_minutes"`
+	Users              []EscalationPathUser `json:"users"`
+}
+
+// EscalationPathUser represents a user paged at an escalation level
+type EscalationPathUser struct {
+	UserID       string `json:"user_id"`
+	UserName     string `json:"user_name,omitempty"`
+	ScheduleID   string `json:"schedule_id,omitempty"`
+	ScheduleName string `json:"schedule_name,omitempty"`
+}
+
+// EscalationPolicyOncall lists who is on call right now at each level of an escalation policy
+type EscalationPolicyOncall struct {
+	EscalationPolicy EscalationPolicyReference `json:"escalation_policy"`
+	Oncalls          []OncallSummary           `json:"oncalls"`
+	// OncallsTruncated reports that more on-call entries exist than were read,
+	// so some levels may be missing
+	OncallsTruncated bool   `json:"oncalls_truncated,omitempty"`
+	Summary          string `json:"summary"`
+}
+
+// EscalationPolicyTargetMatch is an escalation policy that notifies a given user or schedule
+type EscalationPolicyTargetMatch struct {
+	EscalationPolicy EscalationPolicyReference `json:"escalation_policy"`
+	Levels           []int                     `json:"levels"`
 }
 
 // EscalationPolicyQuery represents query parameters for listing escalation policies
@@ -1,6 +1,9 @@
 package models
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // EventOrchestration represents a PagerDuty event orchestration
 type EventOrchestration struct {
@@ -21,9 +24,26 @@ type EventOrchestration struct {
 // EventOrchestrationIntegration represents an integration in an orchestration
 type EventOrchestrationIntegration struct {
 	ID         string `json:"id"`
+	Label      string `json:"label,omitempty"`
 	Parameters *IntegrationParameters `json:"parameters,omitempty"`
 }
 
+// EventOrchestrationIntegrationRequest represents a request to create an integration
+type EventOrchestrationIntegrationRequest struct {
+	Integration EventOrchestrationIntegrationCreate `json:"integration"`
+}
+
+// EventOrchestrationIntegrationCreate represents the fields for creating an integration
+type EventOrchestrationIntegrationCreate struct {
+	Label string `json:"label"`
+}
+
+// EventOrchestrationIntegrationRotation is the result of rotating an integration's routing key
+type EventOrchestrationIntegrationRotation struct {
+	Integration          EventOrchestrationIntegration `json:"integration"`
+	RevokedIntegrationID string                        `json:"revoked_integration_id"`
+}
+
 // IntegrationParameters represents parameters for an integration
 type IntegrationParameters struct {
 	RoutingKey string `json:"routing_key,omitempty"`
@@ -143,6 +163,18 @@ type EventOrchestrationService struct {
 	CatchAll *EventOrchestrationCatchAll `json:"catch_all,omitempty"`
 }
 
+// ServiceEventRules is a service's orchestration rules with rule counts, for
+// readers who think of them as "the service's rules" rather than an
+// orchestration path
+type ServiceEventRules struct {
+	ServiceID    string                      `json:"service_id"`
+	RuleCount    int                         `json:"rule_count"`
+	EnabledCount int                         `json:"enabled_count"`
+	Sets         []EventOrchestrationRuleSet `json:"sets"`
+	CatchAll     *EventOrchestrationCatchAll `json:"catch_all,omitempty"`
+	Summary      string                      `json:"summary"`
+}
+
 // EventOrchestrationRouterUpdateRequest represents a request to update router
 type EventOrchestrationRouterUpdateRequest struct {
 	OrchestrationPath EventOrchestrationPath `json:"orchestration_path"`
@@ -155,6 +187,31 @@ type EventOrchestrationPath struct {
 	CatchAll *EventOrchestrationCatchAll `json:"catch_all,omitempty"`
 }
 
+// EventOrchestrationRawPath is an orchestration path whose rules and
+// catch-all are kept as raw JSON. Tools that read a path, change one rule,
+// and write it back use it so every action the API returns is written back,
+// including actions the typed rule model does not cover.
+type EventOrchestrationRawPath struct {
+	Sets     []EventOrchestrationRawRuleSet `json:"sets,omitempty"`
+	CatchAll json.RawMessage                `json:"catch_all,omitempty"`
+}
+
+// EventOrchestrationRawRuleSet is a rule set whose rules are kept as raw JSON
+type EventOrchestrationRawRuleSet struct {
+	ID    string            `json:"id"`
+	Rules []json.RawMessage `json:"rules,omitempty"`
+}
+
+// EventOrchestrationRawPathResponse is the API response for a path read as raw JSON
+type EventOrchestrationRawPathResponse struct {
+	OrchestrationPath EventOrchestrationRawPath `json:"orchestration_path"`
+}
+
+// EventOrchestrationRawPathUpdateRequest is a request to replace a path read as raw JSON
+type EventOrchestrationRawPathUpdateRequest struct {
+	OrchestrationPath EventOrchestrationRawPath `json:"orchestration_path"`
+}
+
 // EventOrchestrationRuleCreateRequest represents a request to add a rule
 type EventOrchestrationRuleCreateRequest struct {
 	Label      string                         `json:"label,omitempty"`
@@ -177,6 +234,40 @@ type EventOrchestrationsResponse struct {
 	Total          int                  `json:"total"`
 }
 
+// OrchestrationSimulation is the result of evaluating a sample event against orchestration rules
+type OrchestrationSimulation struct {
+	Path         string                         `json:"path"`
+	MatchedRules []OrchestrationSimulationMatch `json:"matched_rules"`
+	CatchAll     bool                           `json:"catch_all"`
+	Actions      *EventOrchestrationRuleActions `json:"actions,omitempty"`
+}
+
+// OrchestrationSimulationMatch is a rule that matched during simulation
+type OrchestrationSimulationMatch struct {
+	SetID   string                        `json:"set_id"`
+	RuleID  string                        `json:"rule_id"`
+	Label   string                        `json:"label,omitempty"`
+	Actions EventOrchestrationRuleActions `json:"actions"`
+}
+
+// ConditionValidation is the result of checking a condition expression
+type ConditionValidation struct {
+	Expression string `json:"expression"`
+	Valid      bool   `json:"valid"`
+	Error      string `json:"error,omitempty"`
+}
+
+// EventOrchestrationIntegrationResponse is the API response wrapper for an integration
+type EventOrchestrationIntegrationResponse struct {
+	Integration EventOrchestrationIntegration `json:"integration"`
+}
+
+// EventOrchestrationIntegrationsResponse is the API response wrapper for multiple integrations
+type EventOrchestrationIntegrationsResponse struct {
+	Integrations []EventOrchestrationIntegration `json:"integrations"`
+	Total        int                             `json:"total"`
+}
+
 // EventOrchestrationRouterResponse is the API response for router
 type EventOrchestrationRouterResponse struct {
 	OrchestrationPath EventOrchestrationRouter `json:"orchestration_path"`
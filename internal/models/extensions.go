@@ -0,0 +1,98 @@
+package models
+
+import "fmt"
+
+// Extension represents an outbound integration that pushes incidents to an external system
+type Extension struct {
+	ID                  string                   `json:"id,omitempty"`
+	Type                string                   `json:"type,omitempty"`
+	Summary             string                   `json:"summary,omitempty"`
+	Self                string                   `json:"self,omitempty"`
+	HTMLURL             string                   `json:"html_url,omitempty"`
+	Name                string                   `json:"name"`
+	EndpointURL         string                   `json:"endpoint_url,omitempty"`
+	ExtensionSchema     ExtensionSchemaReference `json:"extension_schema"`
+	ExtensionObjects    []ServiceReference       `json:"extension_objects"`
+	Config              map[string]any           `json:"config,omitempty"`
+	TemporarilyDisabled bool                     `json:"temporarily_disabled,omitempty"`
+}
+
+// ExtensionSchema describes an extension type (e.g., Slack, ServiceNow, generic webhook)
+type ExtensionSchema struct {
+	ID          string   `json:"id,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Summary     string   `json:"summary,omitempty"`
+	Self        string   `json:"self,omitempty"`
+	Key         string   `json:"key,omitempty"`
+	Label       string   `json:"label,omitempty"`
+	Description string   `json:"description,omitempty"`
+	SendTypes   []string `json:"send_types,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	GuideURL    string   `json:"guide_url,omitempty"`
+	IconURL     string   `json:"icon_url,omitempty"`
+	LogoURL     string   `json:"logo_url,omitempty"`
+}
+
+// ExtensionQuery represents query parameters for listing extensions
+type ExtensionQuery struct {
+	Query             string `json:"query,omitempty"`
+	ExtensionObjectID string `json:"extension_object_id,omitempty"`
+	ExtensionSchemaID string `json:"extension_schema_id,omitempty"`
+	Limit             int    `json:"limit,omitempty"`
+}
+
+// ToParams converts the query to URL parameters
+func (q *ExtensionQuery) ToParams() map[string]string {
+	params := make(map[string]string)
+	if q.Query != "" {
+		params["query"] = q.Query
+	}
+	if q.ExtensionObjectID != "" {
+		params["extension_object_id"] = q.ExtensionObjectID
+	}
+	if q.ExtensionSchemaID != "" {
+		params["extension_schema_id"] = q.ExtensionSchemaID
+	}
+	if q.Limit > 0 {
+		params["limit"] = fmt.Sprintf("%d", q.Limit)
+	}
+	return params
+}
+
+// ExtensionCreateRequest represents a request to create or update an extension
+type ExtensionCreateRequest struct {
+	Extension ExtensionData `json:"extension"`
+}
+
+// ExtensionData represents the fields sent when creating or updating an extension
+type ExtensionData struct {
+	Type             string                    `json:"type"`
+	Name             string                    `json:"name,omitempty"`
+	EndpointURL      string                    `json:"endpoint_url,omitempty"`
+	ExtensionSchema  *ExtensionSchemaReference `json:"extension_schema,omitempty"`
+	ExtensionObjects []ServiceReference        `json:"extension_objects,omitempty"`
+	Config           map[string]any            `json:"config,omitempty"`
+}
+
+// ExtensionResponse is the API response wrapper for a single extension
+type ExtensionResponse struct {
+	Extension Extension `json:"extension"`
+}
+
+// ExtensionsResponse is the API response wrapper for multiple extensions
+type ExtensionsResponse struct {
+	Extensions []Extension `json:"extensions"`
+	Offset     int         `json:"offset"`
+	Limit      int         `json:"limit"`
+	More       bool        `json:"more"`
+	Total      int         `json:"total"`
+}
+
+// ExtensionSchemasResponse is the API response wrapper for multiple extension schemas
+type ExtensionSchemasResponse struct {
+	ExtensionSchemas []ExtensionSchema `json:"extension_schemas"`
+	Offset           int               `json:"offset"`
+	Limit            int               `json:"limit"`
+	More             bool              `json:"more"`
+	Total            int               `json:"total"`
+}
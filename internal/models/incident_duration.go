@@ -0,0 +1,27 @@
+package models
+
+// IncidentDuration breaks down how long an incident spent in each status
+type IncidentDuration struct {
+	Incident IncidentReference `json:"incident"`
+	Status   string            `json:"status"`
+	// CreatedAt is when the incident was triggered
+	CreatedAt PDTime `json:"created_at"`
+	// FirstAcknowledgedAt is unset when the incident was never acknowledged
+	FirstAcknowledgedAt PDTime `json:"first_acknowledged_at,omitzero"`
+	// ResolvedAt is unset while the incident is open
+	ResolvedAt PDTime `json:"resolved_at,omitzero"`
+	// MeasuredUntil is the end of the measured period: ResolvedAt, or now for open incidents
+	MeasuredUntil PDTime `json:"measured_until"`
+
+	TimeToAcknowledgeSeconds int64 `json:"time_to_acknowledge_seconds,omitempty"`
+	TimeToResolveSeconds     int64 `json:"time_to_resolve_seconds,omitempty"`
+	TotalSeconds             int64 `json:"total_seconds"`
+	TriggeredSeconds         int64 `json:"triggered_seconds"`
+	AcknowledgedSeconds      int64 `json:"acknowledged_seconds"`
+
+	// Transitions is the number of status changes found in the log
+	Transitions int `json:"transitions"`
+	// LogTruncated reports that not every log entry was read, so the breakdown may be incomplete
+	LogTruncated bool   `json:"log_truncated,omitempty"`
+	Summary      string `json:"summary"`
+}
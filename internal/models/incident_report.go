@@ -0,0 +1,20 @@
+package models
+
+// IncidentReport gathers what a post-incident review needs into one document
+type IncidentReport struct {
+	Incident      Incident               `json:"incident"`
+	Timeline      []LogEntry             `json:"timeline"`
+	Notes         []IncidentNote         `json:"notes"`
+	ChangeEvents  []ChangeEvent          `json:"change_events"`
+	StatusUpdates []IncidentStatusUpdate `json:"status_updates"`
+	// TimelineTruncated reports that the incident has more log entries than the report includes
+	TimelineTruncated bool `json:"timeline_truncated,omitempty"`
+	// Warnings lists sections that could not be fetched; the rest of the report is still valid
+	Warnings    []string `json:"warnings,omitempty"`
+	GeneratedAt PDTime   `json:"generated_at"`
+}
+
+// IncidentStatusUpdatesResponse is the API response wrapper for an incident's status updates
+type IncidentStatusUpdatesResponse struct {
+	StatusUpdates []IncidentStatusUpdate `json:"status_updates"`
+}
@@ -15,14 +15,14 @@ type Incident struct {
 	HTMLURL               string              `json:"html_url,omitempty"`
 	IncidentNumber        int                 `json:"incident_number,omitempty"`
 	Title                 string              `json:"title,omitempty"`
-	CreatedAt             string              `json:"created_at,omitempty"`
-	UpdatedAt             string              `json:"updated_at,omitempty"`
+	CreatedAt             PDTime              `json:"created_at,omitzero"`
+	UpdatedAt             PDTime              `json:"updated_at,omitzero"`
 	Status                string              `json:"status,omitempty"`
 	IncidentKey           string              `json:"incident_key,omitempty"`
 	Service               *ServiceReference   `json:"service,omitempty"`
 	Assignments           []Assignment        `json:"assignments,omitempty"`
 	Acknowledgements      []Acknowledgement   `json:"acknowledgements,omitempty"`
-	LastStatusChangeAt    string              `json:"last_status_change_at,omitempty"`
+	LastStatusChangeAt    PDTime              `json:"last_status_change_at,omitzero"`
 	LastStatusChangeBy    *UserReference      `json:"last_status_change_by,omitempty"`
 	FirstTriggerLogEntry  *LogEntryReference  `json:"first_trigger_log_entry,omitempty"`
 	EscalationPolicy      *EscalationPolicyReference `json:"escalation_policy,omitempty"`
@@ -34,6 +34,7 @@ type Incident struct {
 	Body                  *IncidentBody       `json:"body,omitempty"`
 	IsMergeable           bool                `json:"is_mergeable,omitempty"`
 	ConferenceBridge      *ConferenceBridge   `json:"conference_bridge,omitempty"`
+	IncidentResponders    []IncidentResponder `json:"incidents_responders,omitempty"`
 }
 
 // Assignment represents an incident assignment
@@ -70,6 +71,16 @@ type AlertCounts struct {
 	Resolved  int `json:"resolved"`
 }
 
+// IncidentAlertSummary is a lightweight triage view of an incident's alert counts
+type IncidentAlertSummary struct {
+	IncidentID     string      `json:"incident_id"`
+	IncidentNumber int         `json:"incident_number,omitempty"`
+	Title          string      `json:"title,omitempty"`
+	Status         string      `json:"status,omitempty"`
+	Urgency        string      `json:"urgency,omitempty"`
+	AlertCounts    AlertCounts `json:"alert_counts"`
+}
+
 // IncidentBody represents the body of an incident
 type IncidentBody struct {
 	Type    string `json:"type"`
@@ -88,14 +99,17 @@ type IncidentQuery struct {
 	DateRange    string   `json:"date_range,omitempty"`
 	Since        string   `json:"since,omitempty"`
 	Until        string   `json:"until,omitempty"`
+	IncidentKey  string   `json:"incident_key,omitempty"`
 	Urgencies    []string `json:"urgencies,omitempty"`
 	ServiceIDs   []string `json:"service_ids,omitempty"`
 	TeamIDs      []string `json:"team_ids,omitempty"`
 	UserIDs      []string `json:"user_ids,omitempty"`
+	PriorityIDs  []string `json:"priority_ids,omitempty"`
 	TimeZone     string   `json:"time_zone,omitempty"`
 	SortBy       string   `json:"sort_by,omitempty"`
 	Includes     []string `json:"include,omitempty"`
 	Limit        int      `json:"limit,omitempty"`
+	Offset       int      `json:"offset,omitempty"`
 	RequestScope string   `json:"request_scope,omitempty"` // "all", "assigned", "teams"
 }
 
@@ -111,6 +125,9 @@ func (q *IncidentQuery) ToParams() map[string]string {
 	if q.Until != "" {
 		params["until"] = q.Until
 	}
+	if q.IncidentKey != "" {
+		params["incident_key"] = q.IncidentKey
+	}
 	if q.TimeZone != "" {
 		params["time_zone"] = q.TimeZone
 	}
@@ -120,6 +137,9 @@ func (q *IncidentQuery) ToParams() map[string]string {
 	if q.Limit > 0 {
 		params["limit"] = fmt.Sprintf("%d", q.Limit)
 	}
+	if q.Offset > 0 {
+		params["offset"] = fmt.Sprintf("%d", q.Offset)
+	}
 	return params
 }
 
@@ -141,6 +161,9 @@ func (q *IncidentQuery) ToArrayParams() map[string][]string {
 	if len(q.UserIDs) > 0 {
 		params["user_ids[]"] = q.UserIDs
 	}
+	if len(q.PriorityIDs) > 0 {
+		params["priority_ids[]"] = q.PriorityIDs
+	}
 	if len(q.Includes) > 0 {
 		params["include[]"] = q.Includes
 	}
@@ -151,6 +174,30 @@ func (q *IncidentQuery) ToArrayParams() map[string][]string {
 	return params
 }
 
+// IncidentSortFields lists the fields PagerDuty allows incidents to be sorted by
+var IncidentSortFields = []string{"incident_number", "created_at", "resolved_at", "urgency"}
+
+// ValidateIncidentSortBy checks a sort_by value such as 'created_at:desc,urgency'
+func ValidateIncidentSortBy(sortBy string) error {
+	for _, part := range strings.Split(sortBy, ",") {
+		field, direction, hasDirection := strings.Cut(strings.TrimSpace(part), ":")
+		if hasDirection && direction != "asc" && direction != "desc" {
+			return fmt.Errorf("invalid sort direction %q: expected 'asc' or 'desc'", direction)
+		}
+		valid := false
+		for _, f := range IncidentSortFields {
+			if field == f {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid sort field %q: expected one of %s", field, strings.Join(IncidentSortFields, ", "))
+		}
+	}
+	return nil
+}
+
 // IncidentCreateRequest represents a request to create an incident
 type IncidentCreateRequest struct {
 	Incident IncidentCreate `json:"incident"`
@@ -170,13 +217,21 @@ type IncidentCreate struct {
 	ConferenceBridge *ConferenceBridge `json:"conference_bridge,omitempty"`
 }
 
+// IncidentCreateResult is the create_incident result when dedup_check is set
+type IncidentCreateResult struct {
+	Deduplicated bool     `json:"deduplicated"`
+	Incident     Incident `json:"incident"`
+}
+
 // IncidentManageRequest represents a request to manage incidents
 type IncidentManageRequest struct {
-	IncidentIDs     []string       `json:"incident_ids"`
-	Status          string         `json:"status,omitempty"`
-	Urgency         string         `json:"urgency,omitempty"`
-	Assignment      *UserReference `json:"assignment,omitempty"`
-	EscalationLevel int            `json:"escalation_level,omitempty"`
+	IncidentIDs      []string                   `json:"incident_ids"`
+	Status           string                     `json:"status,omitempty"`
+	Urgency          string                     `json:"urgency,omitempty"`
+	Assignment       *UserReference             `json:"assignment,omitempty"`
+	EscalationLevel  int                        `json:"escalation_level,omitempty"`
+	EscalationPolicy *EscalationPolicyReference `json:"escalation_policy,omitempty"`
+	Priority         *PriorityReference         `json:"priority,omitempty"`
 }
 
 // ToAPIPayload converts the manage request to the API payload format
@@ -196,6 +251,18 @@ func (r *IncidentManageRequest) ToAPIPayload() map[string]interface{} {
 		if r.EscalationLevel > 0 {
 			incident["escalation_level"] = r.EscalationLevel
 		}
+		if r.EscalationPolicy != nil {
+			incident["escalation_policy"] = map[string]interface{}{
+				"type": "escalation_policy_reference",
+				"id":   r.EscalationPolicy.ID,
+			}
+		}
+		if r.Priority != nil {
+			incident["priority"] = map[string]interface{}{
+				"type": "priority_reference",
+				"id":   r.Priority.ID,
+			}
+		}
 		if r.Assignment != nil {
 			incident["assignments"] = []map[string]interface{}{
 				{
@@ -234,12 +301,30 @@ type IncidentResponderRequestResponse struct {
 	Message      string    `json:"message,omitempty"`
 }
 
+// Responder request states for an IncidentResponder
+const (
+	ResponderStatePending  = "pending"
+	ResponderStateJoined   = "joined"
+	ResponderStateDeclined = "declined"
+)
+
+// IncidentResponder is a user who has been asked to respond to an incident,
+// with whether they have accepted (joined) or declined the request
+type IncidentResponder struct {
+	State       string         `json:"state"`
+	User        UserReference  `json:"user"`
+	Requester   *UserReference `json:"requester,omitempty"`
+	RequestedAt PDTime         `json:"requested_at,omitzero"`
+	UpdatedAt   PDTime         `json:"updated_at,omitzero"`
+	Message     string         `json:"message,omitempty"`
+}
+
 // IncidentNote represents a note on an incident
 type IncidentNote struct {
 	ID        string        `json:"id"`
 	User      UserReference `json:"user"`
 	Content   string        `json:"content"`
-	CreatedAt string        `json:"created_at"`
+	CreatedAt PDTime        `json:"created_at"`
 }
 
 // IncidentNoteCreateRequest represents a request to create a note
@@ -247,11 +332,89 @@ type IncidentNoteCreateRequest struct {
 	Note NoteContent `json:"note"`
 }
 
+// IncidentNoteUpdateRequest represents a request to replace a note's content
+type IncidentNoteUpdateRequest struct {
+	Note NoteContent `json:"note"`
+}
+
 // NoteContent represents note content
 type NoteContent struct {
 	Content string `json:"content"`
 }
 
+// IncidentStatusUpdateRequest represents a request to post a status update to incident subscribers
+type IncidentStatusUpdateRequest struct {
+	Message string `json:"message"`
+	Subject string `json:"subject,omitempty"`
+}
+
+// IncidentStatusUpdate represents a status update broadcast to incident subscribers
+type IncidentStatusUpdate struct {
+	ID        string         `json:"id"`
+	Message   string         `json:"message"`
+	Subject   string         `json:"subject,omitempty"`
+	CreatedAt string         `json:"created_at,omitempty"`
+	Sender    *UserReference `json:"sender,omitempty"`
+}
+
+// IncidentStatusUpdateResponse is the API response wrapper for a status update
+type IncidentStatusUpdateResponse struct {
+	StatusUpdate IncidentStatusUpdate `json:"status_update"`
+}
+
+// IncidentSubscriber represents a user or team subscribed to incident status updates
+type IncidentSubscriber struct {
+	SubscriberID            string                  `json:"subscriber_id"`
+	SubscriberType          string                  `json:"subscriber_type"` // user, team
+	HasIndirectSubscription bool                    `json:"has_indirect_subscription,omitempty"`
+	SubscribedVia           []IncidentSubscribedVia `json:"subscribed_via,omitempty"`
+}
+
+// IncidentSubscribedVia describes how an indirect subscription was made
+type IncidentSubscribedVia struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// IncidentSubscribersResponse is the API response wrapper for incident subscribers
+type IncidentSubscribersResponse struct {
+	Subscribers         []IncidentSubscriber `json:"subscribers"`
+	AccountIsSubscribed bool                 `json:"account_is_subscribed"`
+}
+
+// IncidentSubscriberTarget identifies a subscriber to add or remove
+type IncidentSubscriberTarget struct {
+	SubscriberID   string `json:"subscriber_id"`
+	SubscriberType string `json:"subscriber_type"` // user, team
+}
+
+// IncidentSubscribersRequest represents a request to add or remove subscribers
+type IncidentSubscribersRequest struct {
+	Subscribers []IncidentSubscriberTarget `json:"subscribers"`
+}
+
+// IncidentSubscription represents the result of subscribing an entity to an incident
+type IncidentSubscription struct {
+	AccountID        string `json:"account_id,omitempty"`
+	SubscribableID   string `json:"subscribable_id"`
+	SubscribableType string `json:"subscribable_type"`
+	SubscriberID     string `json:"subscriber_id"`
+	SubscriberType   string `json:"subscriber_type"`
+	Result           string `json:"result,omitempty"`
+}
+
+// IncidentSubscriptionsResponse is the API response wrapper for added subscriptions
+type IncidentSubscriptionsResponse struct {
+	Subscriptions []IncidentSubscription `json:"subscriptions"`
+}
+
+// IncidentUnsubscribeResponse is the API response for removing subscribers
+type IncidentUnsubscribeResponse struct {
+	DeletedCount      int `json:"deleted_count"`
+	UnauthorizedCount int `json:"unauthorized_count"`
+	NonExistentCount  int `json:"non_existent_count"`
+}
+
 // OutlierIncidentQuery represents query parameters for outlier incidents
 type OutlierIncidentQuery struct {
 	Since              string `json:"since,omitempty"`
@@ -283,8 +446,9 @@ type OutlierIncident struct {
 
 // PastIncidentsQuery represents query parameters for past incidents
 type PastIncidentsQuery struct {
-	Limit int  `json:"limit,omitempty"`
-	Total bool `json:"total,omitempty"`
+	Limit             int      `json:"limit,omitempty"`
+	Total             bool     `json:"total,omitempty"`
+	AdditionalDetails []string `json:"additional_details,omitempty"`
 }
 
 // ToParams converts the query to URL parameters
@@ -299,6 +463,18 @@ func (q *PastIncidentsQuery) ToParams() map[string]string {
 	return params
 }
 
+// ToArrayParams converts the query to URL parameters with arrays
+func (q *PastIncidentsQuery) ToArrayParams() map[string][]string {
+	params := make(map[string][]string)
+	if len(q.AdditionalDetails) > 0 {
+		params["additional_details[]"] = q.AdditionalDetails
+	}
+	for k, v := range q.ToParams() {
+		params[k] = []string{v}
+	}
+	return params
+}
+
 // PastIncidentsResponse represents the past incidents response
 type PastIncidentsResponse struct {
 	PastIncidents []PastIncident `json:"past_incidents"`
@@ -325,6 +501,15 @@ func (q *RelatedIncidentsQuery) ToParams() map[string]string {
 	return params
 }
 
+// ToArrayParams converts the query to URL parameters with arrays
+func (q *RelatedIncidentsQuery) ToArrayParams() map[string][]string {
+	params := make(map[string][]string)
+	if len(q.AdditionalDetails) > 0 {
+		params["additional_details[]"] = q.AdditionalDetails
+	}
+	return params
+}
+
 // RelatedIncidentsResponse represents the related incidents response
 type RelatedIncidentsResponse struct {
 	RelatedIncidents []RelatedIncident `json:"related_incidents"`
@@ -341,6 +526,25 @@ type RelationshipType struct {
 	Type string `json:"type"`
 }
 
+// Priority represents an incident priority level
+type Priority struct {
+	ID          string `json:"id"`
+	Type        string `json:"type,omitempty"`
+	Summary     string `json:"summary,omitempty"`
+	Self        string `json:"self,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// PrioritiesResponse is the API response wrapper for priorities
+type PrioritiesResponse struct {
+	Priorities []Priority `json:"priorities"`
+	Offset     int        `json:"offset"`
+	Limit      int        `json:"limit"`
+	More       bool       `json:"more"`
+	Total      int        `json:"total"`
+}
+
 // IncidentResponse is the API response wrapper for a single incident
 type IncidentResponse struct {
 	Incident Incident `json:"incident"`
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// payloadIncidents marshals a manage request payload and returns the decoded incident entries
+func payloadIncidents(t *testing.T, r *IncidentManageRequest) []map[string]interface{} {
+	t.Helper()
+
+	data, err := json.Marshal(r.ToAPIPayload())
+	if err != nil {
+		t.Fatalf("Failed to marshal payload: %v", err)
+	}
+
+	var payload struct {
+		Incidents []map[string]interface{} `json:"incidents"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatalf("Failed to parse payload: %v", err)
+	}
+	if len(payload.Incidents) != len(r.IncidentIDs) {
+		t.Fatalf("Expected %d incident(s), got %d", len(r.IncidentIDs), len(payload.Incidents))
+	}
+	return payload.Incidents
+}
+
+// TestIncidentManageRequest_EscalationPolicy tests that an escalation policy reassignment is combined with status and urgency
+func TestIncidentManageRequest_EscalationPolicy(t *testing.T) {
+	r := &IncidentManageRequest{
+		IncidentIDs:      []string{"PABC123", "PDEF456"},
+		Status:           "acknowledged",
+		Urgency:          "high",
+		EscalationPolicy: &EscalationPolicyReference{ID: "PESCPOL1"},
+	}
+
+	for _, incident := range payloadIncidents(t, r) {
+		if incident["status"] != "acknowledged" {
+			t.Errorf("Expected status 'acknowledged', got '%v'", incident["status"])
+		}
+		if incident["urgency"] != "high" {
+			t.Errorf("Expected urgency 'high', got '%v'", incident["urgency"])
+		}
+
+		policy, ok := incident["escalation_policy"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("Expected escalation_policy object, got '%v'", incident["escalation_policy"])
+		}
+		if policy["id"] != "PESCPOL1" {
+			t.Errorf("Expected escalation_policy id 'PESCPOL1', got '%v'", policy["id"])
+		}
+		if policy["type"] != "escalation_policy_reference" {
+			t.Errorf("Expected escalation_policy type 'escalation_policy_reference', got '%v'", policy["type"])
+		}
+		if _, ok := incident["assignments"]; ok {
+			t.Error("Expected no assignments when reassigning to an escalation policy")
+		}
+	}
+}
+
+// TestIncidentManageRequest_NoEscalationPolicy tests that escalation_policy is omitted when not supplied
+func TestIncidentManageRequest_NoEscalationPolicy(t *testing.T) {
+	r := &IncidentManageRequest{
+		IncidentIDs: []string{"PABC123"},
+		Status:      "resolved",
+	}
+
+	incident := payloadIncidents(t, r)[0]
+	if _, ok := incident["escalation_policy"]; ok {
+		t.Errorf("Expected no escalation_policy, got '%v'", incident["escalation_policy"])
+	}
+}
+
+// TestIncidentManageRequest_Priority tests that the priority reference is included only when supplied
+func TestIncidentManageRequest_Priority(t *testing.T) {
+	withPriority := payloadIncidents(t, &IncidentManageRequest{
+		IncidentIDs: []string{"PABC123"},
+		Priority:    &PriorityReference{ID: "PPRIO1"},
+	})[0]
+
+	priority, ok := withPriority["priority"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected priority object, got '%v'", withPriority["priority"])
+	}
+	if priority["id"] != "PPRIO1" {
+		t.Errorf("Expected priority id 'PPRIO1', got '%v'", priority["id"])
+	}
+	if priority["type"] != "priority_reference" {
+		t.Errorf("Expected priority type 'priority_reference', got '%v'", priority["type"])
+	}
+
+	withoutPriority := payloadIncidents(t, &IncidentManageRequest{
+		IncidentIDs: []string{"PABC123"},
+		Urgency:     "low",
+	})[0]
+	if _, ok := withoutPriority["priority"]; ok {
+		t.Errorf("Expected no priority, got '%v'", withoutPriority["priority"])
+	}
+}
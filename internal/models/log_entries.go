@@ -0,0 +1,120 @@
+package models
+
+import "fmt"
+
+// LogEntry represents an entry in an incident's log (trigger, acknowledge,
+// notify, assign, resolve, and so on)
+type LogEntry struct {
+	ID           string                `json:"id"`
+	Type         string                `json:"type"` // e.g. trigger_log_entry, notify_log_entry
+	Summary      string                `json:"summary,omitempty"`
+	Self         string                `json:"self,omitempty"`
+	HTMLURL      string                `json:"html_url,omitempty"`
+	CreatedAt    PDTime                `json:"created_at,omitzero"`
+	Agent        *LogEntryAgent        `json:"agent,omitempty"`
+	Channel      *LogEntryChannel      `json:"channel,omitempty"`
+	User         *UserReference        `json:"user,omitempty"`
+	Notification *LogEntryNotification `json:"notification,omitempty"`
+	Incident     *IncidentReference    `json:"incident,omitempty"`
+	Service      *ServiceReference     `json:"service,omitempty"`
+	Teams        []TeamReference       `json:"teams,omitempty"`
+}
+
+// LogEntryAgent is the user, service, or integration that caused a log entry
+type LogEntryAgent struct {
+	ID      string `json:"id"`
+	Type    string `json:"type,omitempty"`
+	Summary string `json:"summary,omitempty"`
+	HTMLURL string `json:"html_url,omitempty"`
+}
+
+// LogEntryChannel describes how the logged action happened (e.g., web, api, email).
+// The detail fields are only populated when channels are included.
+type LogEntryChannel struct {
+	Type        string         `json:"type"`
+	Summary     string         `json:"summary,omitempty"`
+	Subject     string         `json:"subject,omitempty"`
+	Body        string         `json:"body,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Client      string         `json:"client,omitempty"`
+	ClientURL   string         `json:"client_url,omitempty"`
+	ServiceKey  string         `json:"service_key,omitempty"`
+	Details     map[string]any `json:"details,omitempty"`
+}
+
+// LogEntryNotification describes the notification sent for a notify_log_entry
+type LogEntryNotification struct {
+	Type    string `json:"type"` // sms_notification, email_notification, phone_notification, push_notification
+	Status  string `json:"status,omitempty"`
+	Address string `json:"address,omitempty"`
+}
+
+// LogEntryResponse is the API response wrapper for a single log entry
+type LogEntryResponse struct {
+	LogEntry LogEntry `json:"log_entry"`
+}
+
+// LogEntriesResponse is the API response wrapper for multiple log entries
+type LogEntriesResponse struct {
+	LogEntries []LogEntry `json:"log_entries"`
+	Offset     int        `json:"offset"`
+	Limit      int        `json:"limit"`
+	More       bool       `json:"more"`
+	Total      int        `json:"total"`
+}
+
+// LogEntryQuery represents query parameters for listing log entries account-wide
+type LogEntryQuery struct {
+	Since      string   `json:"since,omitempty"`
+	Until      string   `json:"until,omitempty"`
+	TeamIDs    []string `json:"team_ids,omitempty"`
+	IsOverview *bool    `json:"is_overview,omitempty"`
+	TimeZone   string   `json:"time_zone,omitempty"`
+	Limit      int      `json:"limit,omitempty"`
+	Offset     int      `json:"offset,omitempty"`
+}
+
+// ToArrayParams converts the query to URL parameters with arrays
+func (q *LogEntryQuery) ToArrayParams() map[string][]string {
+	params := make(map[string][]string)
+	if q.Since != "" {
+		params["since"] = []string{q.Since}
+	}
+	if q.Until != "" {
+		params["until"] = []string{q.Until}
+	}
+	if len(q.TeamIDs) > 0 {
+		params["team_ids[]"] = q.TeamIDs
+	}
+	if q.IsOverview != nil {
+		params["is_overview"] = []string{fmt.Sprintf("%t", *q.IsOverview)}
+	}
+	if q.TimeZone != "" {
+		params["time_zone"] = []string{q.TimeZone}
+	}
+	if q.Limit > 0 {
+		params["limit"] = []string{fmt.Sprintf("%d", q.Limit)}
+	}
+	if q.Offset > 0 {
+		params["offset"] = []string{fmt.Sprintf("%d", q.Offset)}
+	}
+	return params
+}
+
+// IncidentNotification is a notification sent to a user about an incident
+type IncidentNotification struct {
+	LogEntryID string         `json:"log_entry_id"`
+	Channel    string         `json:"channel"` // sms, email, phone, push
+	User       *UserReference `json:"user,omitempty"`
+	Status     string         `json:"status,omitempty"`
+	Address    string         `json:"address,omitempty"`
+	Timestamp  PDTime         `json:"timestamp,omitzero"`
+}
+
+// IncidentNotifications lists the notifications sent for an incident
+type IncidentNotifications struct {
+	Notifications []IncidentNotification `json:"notifications"`
+	// LogTruncated reports that not every log entry was read, so later notifications may be missing
+	LogTruncated bool   `json:"log_truncated,omitempty"`
+	Summary      string `json:"summary"`
+}
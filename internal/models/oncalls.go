@@ -8,8 +8,16 @@ type Oncall struct {
 	EscalationLevel  int                       `json:"escalation_level"`
 	Schedule         *ScheduleReference        `json:"schedule,omitempty"`
 	User             UserReference             `json:"user"`
-	Start            string                    `json:"start,omitempty"`
-	End              string                    `json:"end,omitempty"`
+	Start            PDTime                    `json:"start,omitzero"`
+	End              PDTime                    `json:"end,omitzero"`
+}
+
+// OncallSummary is a simplified view of an on-call entry
+type OncallSummary struct {
+	UserID          string `json:"user_id"`
+	UserName        string `json:"user_name"`
+	EscalationLevel int    `json:"escalation_level"`
+	ScheduleName    string `json:"schedule_name,omitempty"`
 }
 
 // OncallQuery represents query parameters for listing on-calls
@@ -76,3 +84,32 @@ type OncallsResponse struct {
 	More    bool     `json:"more"`
 	Total   int      `json:"total"`
 }
+
+// OncallWorkload lists the open incidents assigned to each current on-call user
+type OncallWorkload struct {
+	Responders []ResponderWorkload `json:"responders"`
+	// OncallsTruncated reports that more on-call entries exist than were read,
+	// so some on-call users may be missing
+	OncallsTruncated bool `json:"oncalls_truncated,omitempty"`
+	// IncidentsTruncated reports that more open incidents exist than were read
+	IncidentsTruncated bool   `json:"incidents_truncated,omitempty"`
+	Summary            string `json:"summary"`
+}
+
+// ResponderWorkload is one on-call user and the open incidents assigned to them
+type ResponderWorkload struct {
+	User             UserReference            `json:"user"`
+	EscalationLevels []int                    `json:"escalation_levels"`
+	Incidents        []OncallWorkloadIncident `json:"incidents"`
+}
+
+// OncallWorkloadIncident is a compact view of an open incident
+type OncallWorkloadIncident struct {
+	ID             string `json:"id"`
+	IncidentNumber int    `json:"incident_number,omitempty"`
+	Title          string `json:"title,omitempty"`
+	Status         string `json:"status"`
+	Urgency        string `json:"urgency,omitempty"`
+	CreatedAt      PDTime `json:"created_at,omitzero"`
+	HTMLURL        string `json:"html_url,omitempty"`
+}
@@ -71,3 +71,12 @@ type PriorityReference struct {
 	Self    string `json:"self,omitempty"`
 	HTMLURL string `json:"html_url,omitempty"`
 }
+
+// ExtensionSchemaReference represents a reference to an extension schema
+type ExtensionSchemaReference struct {
+	ID      string `json:"id"`
+	Type    string `json:"type,omitempty"`
+	Summary string `json:"summary,omitempty"`
+	Self    string `json:"self,omitempty"`
+	HTMLURL string `json:"html_url,omitempty"`
+}
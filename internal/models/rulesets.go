@@ -0,0 +1,122 @@
+package models
+
+// Ruleset represents a legacy PagerDuty ruleset (superseded by event orchestrations)
+type Ruleset struct {
+	ID          string         `json:"id,omitempty"`
+	Type        string         `json:"type,omitempty"` // global, default_global
+	Self        string         `json:"self,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	RoutingKeys []string       `json:"routing_keys,omitempty"`
+	Team        *TeamReference `json:"team,omitempty"`
+	CreatedAt   string         `json:"created_at,omitempty"`
+	UpdatedAt   string         `json:"updated_at,omitempty"`
+}
+
+// RulesetRule represents an event rule within a ruleset
+type RulesetRule struct {
+	ID         string                 `json:"id,omitempty"`
+	Self       string                 `json:"self,omitempty"`
+	Position   int                    `json:"position"`
+	Disabled   bool                   `json:"disabled"`
+	CatchAll   bool                   `json:"catch_all,omitempty"`
+	Conditions *RulesetRuleConditions `json:"conditions,omitempty"`
+	Actions    *RulesetRuleActions    `json:"actions,omitempty"`
+}
+
+// RulesetRuleConditions combines subconditions with a logical operator
+type RulesetRuleConditions struct {
+	Operator      string                `json:"operator"` // and, or
+	Subconditions []RulesetSubcondition `json:"subconditions"`
+}
+
+// RulesetSubcondition compares one event field against a value
+type RulesetSubcondition struct {
+	Operator   string                        `json:"operator"` // exists, nexists, equals, nequals, contains, ncontains, matches, nmatches
+	Parameters RulesetSubconditionParameters `json:"parameters"`
+}
+
+// RulesetSubconditionParameters names the event field and the value to compare it with
+type RulesetSubconditionParameters struct {
+	Path  string `json:"path"`
+	Value string `json:"value,omitempty"`
+}
+
+// RulesetRuleActions represents the actions applied when a rule matches
+type RulesetRuleActions struct {
+	Route       *RulesetActionValue `json:"route,omitempty"`
+	Severity    *RulesetActionValue `json:"severity,omitempty"`
+	Priority    *RulesetActionValue `json:"priority,omitempty"`
+	Annotate    *RulesetActionValue `json:"annotate,omitempty"`
+	EventAction *RulesetActionValue `json:"event_action,omitempty"`
+	Suppress    *RulesetSuppress    `json:"suppress,omitempty"`
+	Suspend     *RulesetSuspend     `json:"suspend,omitempty"`
+	Extractions []RulesetExtraction `json:"extractions,omitempty"`
+}
+
+// RulesetActionValue is the single value set by most rule actions
+type RulesetActionValue struct {
+	Value string `json:"value"`
+}
+
+// RulesetSuppress represents a rule's suppress action
+type RulesetSuppress struct {
+	Value               bool   `json:"value"`
+	ThresholdValue      int    `json:"threshold_value,omitempty"`
+	ThresholdTimeUnit   string `json:"threshold_time_unit,omitempty"`
+	ThresholdTimeAmount int    `json:"threshold_time_amount,omitempty"`
+}
+
+// RulesetSuspend represents a rule's suspend action, which holds alerts for
+// Value seconds before they create incidents
+type RulesetSuspend struct {
+	Value int `json:"value"`
+}
+
+// RulesetExtraction copies or templates a value into an event field
+type RulesetExtraction struct {
+	Target   string `json:"target"`
+	Source   string `json:"source,omitempty"`
+	Regex    string `json:"regex,omitempty"`
+	Template string `json:"template,omitempty"`
+}
+
+// RulesetRuleData represents the fields sent when creating or updating a rule
+type RulesetRuleData struct {
+	Position   *int                   `json:"position,omitempty"`
+	Disabled   *bool                  `json:"disabled,omitempty"`
+	Conditions *RulesetRuleConditions `json:"conditions,omitempty"`
+	Actions    *RulesetRuleActions    `json:"actions,omitempty"`
+}
+
+// RulesetRuleRequest represents a request to create or update a rule
+type RulesetRuleRequest struct {
+	Rule RulesetRuleData `json:"rule"`
+}
+
+// RulesetResponse is the API response wrapper for a single ruleset
+type RulesetResponse struct {
+	Ruleset Ruleset `json:"ruleset"`
+}
+
+// RulesetsResponse is the API response wrapper for multiple rulesets
+type RulesetsResponse struct {
+	Rulesets []Ruleset `json:"rulesets"`
+	Offset   int       `json:"offset"`
+	Limit    int       `json:"limit"`
+	More     bool      `json:"more"`
+	Total    int       `json:"total"`
+}
+
+// RulesetRuleResponse is the API response wrapper for a single rule
+type RulesetRuleResponse struct {
+	Rule RulesetRule `json:"rule"`
+}
+
+// RulesetRulesResponse is the API response wrapper for multiple rules
+type RulesetRulesResponse struct {
+	Rules  []RulesetRule `json:"rules"`
+	Offset int           `json:"offset"`
+	Limit  int           `json:"limit"`
+	More   bool          `json:"more"`
+	Total  int           `json:"total"`
+}
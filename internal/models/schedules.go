@@ -50,8 +50,8 @@ type ScheduleLayerRestriction struct {
 
 // RenderedScheduleEntry represents a rendered schedule entry
 type RenderedScheduleEntry struct {
-	Start string        `json:"start"`
-	End   string        `json:"end"`
+	Start PDTime        `json:"start"`
+	End   PDTime        `json:"end"`
 	User  UserReference `json:"user"`
 }
 
@@ -62,6 +62,31 @@ type Subschedule struct {
 	RenderedCoveragePercentage float64              `json:"rendered_coverage_percentage,omitempty"`
 }
 
+// ScheduleCoverageGap represents a time range with no one on call
+type ScheduleCoverageGap struct {
+	GapStart string `json:"gap_start"`
+	GapEnd   string `json:"gap_end"`
+}
+
+// ScheduleCoverageReport summarizes coverage gaps for a schedule over a time range
+type ScheduleCoverageReport struct {
+	ScheduleID         string                `json:"schedule_id"`
+	Since              string                `json:"since"`
+	Until              string                `json:"until"`
+	CoveragePercentage float64               `json:"coverage_percentage"`
+	Gaps               []ScheduleCoverageGap `json:"gaps"`
+	Warning            string                `json:"warning,omitempty"`
+}
+
+// SchedulePreview is the rendered on-call timeline of a proposed schedule
+type SchedulePreview struct {
+	Since              string                  `json:"since"`
+	Until              string                  `json:"until"`
+	Entries            []RenderedScheduleEntry `json:"entries"`
+	CoveragePercentage float64                 `json:"coverage_percentage"`
+	Gaps               []ScheduleCoverageGap   `json:"gaps"`
+}
+
 // ScheduleQuery represents query parameters for listing schedules
 type ScheduleQuery struct {
 	Query string `json:"query,omitempty"`
@@ -134,8 +159,8 @@ type OverrideData struct {
 // ScheduleOverride represents a schedule override
 type ScheduleOverride struct {
 	ID    string        `json:"id"`
-	Start string        `json:"start"`
-	End   string        `json:"end"`
+	Start PDTime        `json:"start"`
+	End   PDTime        `json:"end"`
 	User  UserReference `json:"user"`
 }
 
@@ -162,3 +187,23 @@ type ScheduleUsersResponse struct {
 type ScheduleOverrideResponse struct {
 	Override ScheduleOverride `json:"override"`
 }
+
+// OncallHandoff is a point where on-call passes from one user to another. A nil
+// Outgoing or Incoming means nobody was or will be on call (a coverage gap).
+type OncallHandoff struct {
+	At       PDTime         `json:"at"`
+	Outgoing *UserReference `json:"outgoing,omitempty"`
+	Incoming *UserReference `json:"incoming,omitempty"`
+}
+
+// OncallHandoffReport lists the handoffs in a schedule over a look-ahead window
+type OncallHandoffReport struct {
+	ScheduleID    string          `json:"schedule_id"`
+	ScheduleName  string          `json:"schedule_name,omitempty"`
+	Since         string          `json:"since"`
+	Until         string          `json:"until"`
+	CurrentOncall *UserReference  `json:"current_oncall,omitempty"`
+	NextHandoff   *OncallHandoff  `json:"next_handoff,omitempty"`
+	Handoffs      []OncallHandoff `json:"handoffs"`
+	Summary       string          `json:"summary"`
+}
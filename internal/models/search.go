@@ -0,0 +1,9 @@
+package models
+
+// SearchResult is a single match from the cross-resource search tool
+type SearchResult struct {
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	HTMLURL string `json:"html_url,omitempty"`
+}
@@ -0,0 +1,56 @@
+package models
+
+// ServiceDependency is an edge in the service graph: DependentService relies
+// on SupportingService, so an outage of the supporting service impacts it
+type ServiceDependency struct {
+	ID                string           `json:"id,omitempty"`
+	Type              string           `json:"type,omitempty"`
+	SupportingService ServiceReference `json:"supporting_service"`
+	DependentService  ServiceReference `json:"dependent_service"`
+}
+
+// ServiceDependenciesResponse is the API response wrapper for a service's dependencies
+type ServiceDependenciesResponse struct {
+	Relationships []ServiceDependency `json:"relationships"`
+}
+
+// BusinessService represents a customer-facing PagerDuty business service
+type BusinessService struct {
+	ID             string         `json:"id"`
+	Type           string         `json:"type,omitempty"`
+	Name           string         `json:"name,omitempty"`
+	Description    string         `json:"description,omitempty"`
+	PointOfContact string         `json:"point_of_contact,omitempty"`
+	Team           *TeamReference `json:"team,omitempty"`
+	HTMLURL        string         `json:"html_url,omitempty"`
+}
+
+// BusinessServiceResponse is the API response wrapper for a single business service
+type BusinessServiceResponse struct {
+	BusinessService BusinessService `json:"business_service"`
+}
+
+// IncidentBusinessImpact lists the business services that depend, directly or
+// transitively, on an incident's service
+type IncidentBusinessImpact struct {
+	IncidentID       string                    `json:"incident_id"`
+	IncidentStatus   string                    `json:"incident_status,omitempty"`
+	Service          *ServiceReference         `json:"service,omitempty"`
+	BusinessServices []ImpactedBusinessService `json:"business_services"`
+	Truncated        bool                      `json:"truncated,omitempty"`
+	Summary          string                    `json:"summary"`
+}
+
+// ImpactedBusinessService is a business service reached by walking the service graph
+type ImpactedBusinessService struct {
+	ID             string `json:"id"`
+	Name           string `json:"name,omitempty"`
+	PointOfContact string `json:"point_of_contact,omitempty"`
+	// IncidentStatusDerived is "impacted" while this incident is open and
+	// "recovered" once it is resolved. It is derived from this incident alone,
+	// not PagerDuty's impact data, so another open incident may still affect
+	// the business service.
+	IncidentStatusDerived string `json:"incident_status_derived"`
+	// Path is the chain of service IDs from the incident's service to this one
+	Path []string `json:"path"`
+}
@@ -0,0 +1,14 @@
+package models
+
+// ServiceHealth is a one-shot health read of a service
+type ServiceHealth struct {
+	Service ServiceReference `json:"service"`
+	// Status is the service's PagerDuty status (active, warning, critical, maintenance, disabled)
+	Status            string `json:"status"`
+	OpenIncidentCount int    `json:"open_incident_count"`
+	// LastIncidentAt is when the most recent incident was created, in any status
+	LastIncidentAt PDTime                `json:"last_incident_at,omitzero"`
+	RecentChanges  []ChangeTimelineEntry `json:"recent_changes"`
+	// Warnings lists parts that could not be fetched; the rest of the result is still valid
+	Warnings []string `json:"warnings,omitempty"`
+}
@@ -67,6 +67,7 @@ type ServiceQuery struct {
 	Query    string   `json:"query,omitempty"`
 	TeamIDs  []string `json:"team_ids,omitempty"`
 	Limit    int      `json:"limit,omitempty"`
+	Offset   int      `json:"offset,omitempty"`
 	Includes []string `json:"include,omitempty"`
 }
 
@@ -79,6 +80,9 @@ func (q *ServiceQuery) ToParams() map[string]string {
 	if q.Limit > 0 {
 		params["limit"] = fmt.Sprintf("%d", q.Limit)
 	}
+	if q.Offset > 0 {
+		params["offset"] = fmt.Sprintf("%d", q.Offset)
+	}
 	return params
 }
 
@@ -97,6 +101,9 @@ func (q *ServiceQuery) ToArrayParams() map[string][]string {
 	if q.Limit > 0 {
 		params["limit"] = []string{fmt.Sprintf("%d", q.Limit)}
 	}
+	if q.Offset > 0 {
+		params["offset"] = []string{fmt.Sprintf("%d", q.Offset)}
+	}
 	return params
 }
 
@@ -126,6 +133,18 @@ type ServiceUpdate struct {
 	EscalationPolicy *EscalationPolicyReference `json:"escalation_policy,omitempty"`
 }
 
+// ServiceTeamsUpdateRequest represents a request to replace the teams that own a service
+type ServiceTeamsUpdateRequest struct {
+	Service ServiceTeamsUpdate `json:"service"`
+}
+
+// ServiceTeamsUpdate sets a service's full team list. Teams is always sent,
+// so an empty list removes the service from every team.
+type ServiceTeamsUpdate struct {
+	Type  string          `json:"type"`
+	Teams []TeamReference `json:"teams"`
+}
+
 // ServiceResponse is the API response wrapper for a single service
 type ServiceResponse struct {
 	Service Service `json:"service"`
@@ -88,3 +88,26 @@ type TeamMembersResponse struct {
 	More    bool         `json:"more"`
 	Total   int          `json:"total"`
 }
+
+// TeamResources lists the services and escalation policies a team owns
+type TeamResources struct {
+	TeamID             string             `json:"team_id"`
+	Services           []Service          `json:"services"`
+	EscalationPolicies []EscalationPolicy `json:"escalation_policies"`
+	Summary            string             `json:"summary"`
+}
+
+// TeamMembersAddResult reports the outcome of adding several users to a team.
+// Each user is added independently, so some may fail while others succeed.
+type TeamMembersAddResult struct {
+	TeamID  string                 `json:"team_id"`
+	Added   []string               `json:"added"`
+	Failed  []TeamMemberAddFailure `json:"failed"`
+	Summary string                 `json:"summary"`
+}
+
+// TeamMemberAddFailure is a user that could not be added to a team
+type TeamMemberAddFailure struct {
+	UserID string `json:"user_id"`
+	Error  string `json:"error"`
+}
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// pdTimeLayouts are the timestamp formats accepted from the PagerDuty API
+var pdTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+}
+
+// PDTime is a PagerDuty timestamp. It unmarshals RFC3339 strings (and empty
+// strings or null as the zero time) and marshals back to RFC3339, preserving
+// the original UTC offset. Use the omitzero tag to omit unset times.
+type PDTime struct {
+	time.Time
+}
+
+// ParsePDTime parses a PagerDuty timestamp string
+func ParsePDTime(s string) (PDTime, error) {
+	if s == "" {
+		return PDTime{}, nil
+	}
+	for _, layout := range pdTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return PDTime{Time: t}, nil
+		}
+	}
+	return PDTime{}, fmt.Errorf("invalid timestamp %q: expected RFC3339", s)
+}
+
+// String returns the timestamp in RFC3339 format, or "" for the zero time
+func (t PDTime) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// InLocation returns the same instant rendered in loc. The zero time is
+// returned unchanged so it still marshals as "".
+func (t PDTime) InLocation(loc *time.Location) PDTime {
+	if t.IsZero() {
+		return t
+	}
+	return PDTime{Time: t.In(loc)}
+}
+
+// MarshalJSON implements json.Marshaler
+func (t PDTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *PDTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = PDTime{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid timestamp: %w", err)
+	}
+	parsed, err := ParsePDTime(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
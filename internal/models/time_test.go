@@ -0,0 +1,73 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestPDTime_Unmarshal tests parsing of PagerDuty timestamp formats
+func TestPDTime_Unmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "utc", input: `"2024-01-15T10:00:00Z"`, want: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)},
+		{name: "offset", input: `"2024-01-15T05:00:00-05:00"`, want: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)},
+		{name: "fractional seconds", input: `"2024-01-15T10:00:00.250Z"`, want: time.Date(2024, 1, 15, 10, 0, 0, 250000000, time.UTC)},
+		{name: "empty string", input: `""`},
+		{name: "null", input: `null`},
+		{name: "invalid", input: `"yesterday"`, wantErr: true},
+		{name: "not a string", input: `12345`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got PDTime
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error for %s, got %v", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got.Time)
+			}
+		})
+	}
+}
+
+// TestPDTime_RoundTrip tests that timestamps marshal back to the same JSON and unset times are omitted
+func TestPDTime_RoundTrip(t *testing.T) {
+	input := `{"id":"PABC123","created_at":"2024-01-15T05:00:00-05:00","last_status_change_at":"2024-01-15T10:30:00Z"}`
+
+	var incident Incident
+	if err := json.Unmarshal([]byte(input), &incident); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(incident)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var output map[string]any
+	if err := json.Unmarshal(data, &output); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if output["created_at"] != "2024-01-15T05:00:00-05:00" {
+		t.Errorf("Expected created_at '2024-01-15T05:00:00-05:00', got %v", output["created_at"])
+	}
+	if output["last_status_change_at"] != "2024-01-15T10:30:00Z" {
+		t.Errorf("Expected last_status_change_at '2024-01-15T10:30:00Z', got %v", output["last_status_change_at"])
+	}
+	if _, ok := output["updated_at"]; ok {
+		t.Errorf("Expected unset updated_at to be omitted, got %v", output["updated_at"])
+	}
+}
@@ -72,3 +72,12 @@ type UsersResponse struct {
 	More   bool   `json:"more"`
 	Total  int    `json:"total"`
 }
+
+// UserContext combines a user with the teams, schedules, and on-call shifts they belong to
+type UserContext struct {
+	User      User                `json:"user"`
+	Teams     []TeamReference     `json:"teams"`
+	Schedules []ScheduleReference `json:"schedules"`
+	Oncalls   []Oncall            `json:"oncalls"`
+	Summary   string              `json:"summary"`
+}
@@ -0,0 +1,36 @@
+package models
+
+// WebhookSubscription represents a v3 webhook subscription that delivers
+// events to an outbound URL
+type WebhookSubscription struct {
+	ID             string                    `json:"id"`
+	Type           string                    `json:"type,omitempty"`
+	Active         bool                      `json:"active"`
+	Description    string                    `json:"description,omitempty"`
+	DeliveryMethod WebhookDeliveryMethod     `json:"delivery_method"`
+	Events         []string                  `json:"events,omitempty"`
+	Filter         WebhookSubscriptionFilter `json:"filter"`
+}
+
+// WebhookDeliveryMethod is where and how a webhook subscription delivers events.
+// The signing secret and custom headers are not modelled so they are never echoed.
+type WebhookDeliveryMethod struct {
+	Type                string `json:"type,omitempty"`
+	URL                 string `json:"url,omitempty"`
+	TemporarilyDisabled bool   `json:"temporarily_disabled,omitempty"`
+}
+
+// WebhookSubscriptionFilter scopes a subscription to the account, a service, or a team
+type WebhookSubscriptionFilter struct {
+	Type string `json:"type"` // account_reference, service_reference, team_reference
+	ID   string `json:"id,omitempty"`
+}
+
+// WebhookSubscriptionsResponse is the API response wrapper for multiple webhook subscriptions
+type WebhookSubscriptionsResponse struct {
+	WebhookSubscriptions []WebhookSubscription `json:"webhook_subscriptions"`
+	Offset               int                   `json:"offset"`
+	Limit                int                   `json:"limit"`
+	More                 bool                  `json:"more"`
+	Total                int                   `json:"total"`
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/auth"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// redactedArgumentMarkers are substrings of argument names whose values are
+// never written to the audit log
+var redactedArgumentMarkers = []string{"token", "secret", "password", "api_key", "routing_key", "integration_key", "confirm"}
+
+// AuditEntry is an audit log record for a single write tool invocation
+type AuditEntry struct {
+	Time      time.Time      `json:"time"`
+	Tool      string         `json:"tool"`
+	From      string         `json:"from,omitempty"`
+	RequestID string         `json:"request_id,omitempty"`
+	Arguments map[string]any `json:"arguments"`
+	DryRun    bool           `json:"dry_run,omitempty"`
+	IsError   bool           `json:"is_error"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// auditLogger writes one JSON line per write tool invocation
+type auditLogger struct {
+	mu  sync.Mutex
+	w   io.Writer
+	now func() time.Time
+}
+
+// newAuditLogger creates an auditLogger writing to w
+func newAuditLogger(w io.Writer) *auditLogger {
+	return &auditLogger{w: w, now: time.Now}
+}
+
+// wrap returns a handler that records each call to next in the audit log
+func (a *auditLogger) wrap(name string, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		entry := AuditEntry{
+			Time:      a.now().UTC(),
+			Tool:      name,
+			Arguments: redactArguments(request.GetArguments()),
+			DryRun:    client.IsDryRun(ctx),
+		}
+		if from, ok := auth.GetFromEmail(ctx); ok {
+			entry.From = from
+		}
+		if id, ok := client.RequestID(ctx); ok {
+			entry.RequestID = id
+		}
+
+		result, err := next(ctx, request)
+
+		switch {
+		case err != nil:
+			entry.IsError = true
+			entry.Error = err.Error()
+		case result != nil && result.IsError:
+			entry.IsError = true
+			if len(result.Content) > 0 {
+				if text, ok := result.Content[0].(mcp.TextContent); ok {
+					entry.Error = text.Text
+				}
+			}
+		}
+		a.write(entry)
+
+		return result, err
+	}
+}
+
+// write appends the entry to the log as a single JSON line
+func (a *auditLogger) write(entry AuditEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	data = append(data, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	_, _ = a.w.Write(data)
+}
+
+// redactArguments copies args, replacing the values of sensitive arguments
+func redactArguments(args map[string]any) map[string]any {
+	redacted := make(map[string]any, len(args))
+	for k, v := range args {
+		redacted[k] = v
+		lower := strings.ToLower(k)
+		for _, marker := range redactedArgumentMarkers {
+			if strings.Contains(lower, marker) {
+				redacted[k] = "[REDACTED]"
+				break
+			}
+		}
+	}
+	return redacted
+}
+
+// auditTools wraps each named tool that is still registered with the audit logger
+func auditTools(s *server.MCPServer, a *auditLogger, names []string) {
+	for _, name := range names {
+		registered := s.GetTool(name)
+		if registered == nil {
+			continue
+		}
+		s.AddTool(registered.Tool, a.wrap(name, registered.Handler))
+	}
+}
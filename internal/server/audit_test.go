@@ -0,0 +1,72 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/auth"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestAuditLog_CreateCall tests that a create call is written to the audit log with redacted arguments
+func TestAuditLog_CreateCall(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"team":{"id":"PTEAM1","name":"Platform"}}`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	pdClient := client.NewClient(client.Config{APIKey: "test-api-key", APIHost: ts.URL})
+	s := New(Config{EnableWriteTools: true, AuditLog: &buf}, pdClient)
+
+	var request mcp.CallToolRequest
+	request.Params.Arguments = map[string]any{"name": "Platform", "api_token": "secret-value"}
+	ctx := auth.WithFromEmail(context.Background(), "oncall@example.com")
+	if _, err := s.GetTool("create_team").Handler(ctx, request); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var entry AuditEntry
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Expected one JSON audit line, got %q: %v", buf.String(), err)
+	}
+	if entry.Tool != "create_team" {
+		t.Errorf("Expected tool 'create_team', got '%s'", entry.Tool)
+	}
+	if entry.From != "oncall@example.com" {
+		t.Errorf("Expected from 'oncall@example.com', got '%s'", entry.From)
+	}
+	if entry.Arguments["name"] != "Platform" {
+		t.Errorf("Expected name argument 'Platform', got %v", entry.Arguments["name"])
+	}
+	if entry.Arguments["api_token"] != "[REDACTED]" {
+		t.Errorf("Expected api_token to be redacted, got %v", entry.Arguments["api_token"])
+	}
+	if entry.IsError || entry.Time.IsZero() {
+		t.Errorf("Expected successful entry with a timestamp, got %+v", entry)
+	}
+}
+
+// TestAuditLog_ReadToolsNotLogged tests that read-only tools are not audited
+func TestAuditLog_ReadToolsNotLogged(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"teams":[]}`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	pdClient := client.NewClient(client.Config{APIKey: "test-api-key", APIHost: ts.URL})
+	s := New(Config{EnableWriteTools: true, AuditLog: &buf}, pdClient)
+
+	if _, err := s.GetTool("list_teams").Handler(context.Background(), mcp.CallToolRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Expected no audit entry for list_teams, got %q", buf.String())
+	}
+}
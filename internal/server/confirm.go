@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DestructiveTools lists the tools that permanently remove data or access and
+// require confirmation when Config.RequireConfirmation is set
+var DestructiveTools = []string{
+	"delete_team",
+	"delete_alert_grouping_setting",
+	"remove_team_member",
+	"remove_incident_subscribers",
+	"rotate_event_orchestration_integration_key",
+	"delete_service",
+	"remove_service_team",
+	"remove_escalation_target",
+	"delete_ruleset_rule",
+	"delete_extension",
+	"delete_addon",
+}
+
+// confirmationTTL is how long a confirmation token remains valid
+const confirmationTTL = 5 * time.Minute
+
+// pendingConfirmation is an issued confirmation token awaiting use
+type pendingConfirmation struct {
+	key     string
+	expires time.Time
+}
+
+// confirmationStore issues and redeems single-use confirmation tokens. Each
+// token is bound to the tool name and arguments of the call that requested it.
+type confirmationStore struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	pending map[string]pendingConfirmation
+}
+
+// newConfirmationStore creates a confirmationStore whose tokens expire after ttl
+func newConfirmationStore(ttl time.Duration) *confirmationStore {
+	return &confirmationStore{
+		ttl:     ttl,
+		now:     time.Now,
+		pending: make(map[string]pendingConfirmation),
+	}
+}
+
+// issue creates a token for the call identified by key
+func (cs *confirmationStore) issue(key string) (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate confirmation token: %w", err)
+	}
+	token := hex.EncodeToString(b)
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	now := cs.now()
+	for t, p := range cs.pending {
+		if now.After(p.expires) {
+			delete(cs.pending, t)
+		}
+	}
+	cs.pending[token] = pendingConfirmation{key: key, expires: now.Add(cs.ttl)}
+	return token, nil
+}
+
+// redeem consumes the token if it was issued for key and has not expired
+func (cs *confirmationStore) redeem(token, key string) bool {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	p, ok := cs.pending[token]
+	if !ok || p.key != key {
+		return false
+	}
+	delete(cs.pending, token)
+	return !cs.now().After(p.expires)
+}
+
+// wrap returns a handler that only runs next when called with a valid confirm
+// token. A call without confirm returns a new token and does not run next.
+func (cs *confirmationStore) wrap(name string, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// A dry run changes nothing, so it needs no confirmation
+		if client.IsDryRun(ctx) {
+			return next(ctx, request)
+		}
+
+		args := request.GetArguments()
+		key, err := confirmationKey(name, args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if confirm, _ := args["confirm"].(string); confirm != "" {
+			if !cs.redeem(confirm, key) {
+				return mcp.NewToolResultError("invalid or expired confirmation token. Call again without 'confirm' to get a new token."), nil
+			}
+			return next(ctx, request)
+		}
+
+		token, err := cs.issue(key)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf(
+			"CONFIRMATION REQUIRED: %s was NOT run. Confirm this action with the user, then call %s again with the same arguments and confirm: %q. The token expires in %s.",
+			name, name, token, cs.ttl)), nil
+	}
+}
+
+// confirmationKey identifies a call by tool name and arguments, excluding confirm
+func confirmationKey(name string, args map[string]any) (string, error) {
+	rest := make(map[string]any, len(args))
+	for k, v := range args {
+		if k != "confirm" {
+			rest[k] = v
+		}
+	}
+	data, err := json.Marshal(rest)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode arguments: %w", err)
+	}
+	return name + ":" + string(data), nil
+}
+
+// requireConfirmation wraps each registered destructive tool so it runs only
+// after a confirmation round trip, and adds the confirm argument to its schema
+func requireConfirmation(s *server.MCPServer, cs *confirmationStore) {
+	for _, name := range DestructiveTools {
+		registered := s.GetTool(name)
+		if registered == nil {
+			continue
+		}
+
+		tool := withArgument(registered.Tool, mcp.WithString("confirm",
+			mcp.Description("Confirmation token returned by a previous call with the same arguments. Omit on the first call.")))
+		s.AddTool(tool, cs.wrap(name, registered.Handler))
+	}
+}
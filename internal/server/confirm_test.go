@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// confirmTokenPattern extracts the token from a confirmation challenge
+var confirmTokenPattern = regexp.MustCompile(`confirm: "([0-9a-f]+)"`)
+
+// callWithArgs invokes a tool handler with the given arguments
+func callWithArgs(t *testing.T, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	var request mcp.CallToolRequest
+	request.Params.Arguments = args
+	result, err := handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return result
+}
+
+// resultText returns the text of the first content item
+func resultText(result *mcp.CallToolResult) string {
+	if text, ok := result.Content[0].(mcp.TextContent); ok {
+		return text.Text
+	}
+	return ""
+}
+
+// TestConfirmation_TwoPhase tests that the action only runs after calling back with the issued token
+func TestConfirmation_TwoPhase(t *testing.T) {
+	calls := 0
+	next := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		return mcp.NewToolResultText("deleted"), nil
+	}
+	handler := newConfirmationStore(time.Minute).wrap("delete_team", next)
+
+	// First call returns a challenge and does not delete
+	challenge := callWithArgs(t, handler, map[string]any{"team_id": "PTEAM1"})
+	if calls != 0 {
+		t.Fatalf("Expected no deletion on first call, got %d call(s)", calls)
+	}
+	match := confirmTokenPattern.FindStringSubmatch(resultText(challenge))
+	if match == nil {
+		t.Fatalf("Expected confirmation token in response, got: %s", resultText(challenge))
+	}
+	token := match[1]
+
+	// A token issued for different arguments is rejected
+	result := callWithArgs(t, handler, map[string]any{"team_id": "PTEAM2", "confirm": token})
+	if !result.IsError || calls != 0 {
+		t.Errorf("Expected token for PTEAM1 to be rejected for PTEAM2")
+	}
+
+	// Confirming with the same arguments runs the action
+	result = callWithArgs(t, handler, map[string]any{"team_id": "PTEAM1", "confirm": token})
+	if result.IsError || calls != 1 || resultText(result) != "deleted" {
+		t.Errorf("Expected deletion after confirmation, got calls=%d result=%s", calls, resultText(result))
+	}
+
+	// Tokens are single use
+	result = callWithArgs(t, handler, map[string]any{"team_id": "PTEAM1", "confirm": token})
+	if !result.IsError || calls != 1 {
+		t.Errorf("Expected reused token to be rejected, got calls=%d", calls)
+	}
+}
+
+// TestConfirmation_Expired tests that an expired token does not run the action
+func TestConfirmation_Expired(t *testing.T) {
+	calls := 0
+	next := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		return mcp.NewToolResultText("deleted"), nil
+	}
+	store := newConfirmationStore(time.Minute)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	handler := store.wrap("delete_team", next)
+
+	challenge := callWithArgs(t, handler, map[string]any{"team_id": "PTEAM1"})
+	token := confirmTokenPattern.FindStringSubmatch(resultText(challenge))[1]
+
+	now = now.Add(2 * time.Minute)
+	result := callWithArgs(t, handler, map[string]any{"team_id": "PTEAM1", "confirm": token})
+	if !result.IsError || calls != 0 {
+		t.Errorf("Expected expired token to be rejected, got calls=%d", calls)
+	}
+}
+
+// TestNew_RequireConfirmation tests that destructive tools gain a confirm argument when enabled
+func TestNew_RequireConfirmation(t *testing.T) {
+	pdClient := newTestPDClient()
+	s := New(Config{EnableWriteTools: true, RequireConfirmation: true}, pdClient)
+
+	tool := s.GetTool("delete_team")
+	if tool == nil {
+		t.Fatal("Expected delete_team to be registered")
+	}
+	if _, ok := tool.Tool.InputSchema.Properties["confirm"]; !ok {
+		t.Error("Expected delete_team to accept a confirm argument")
+	}
+	if _, ok := s.GetTool("create_team").Tool.InputSchema.Properties["confirm"]; ok {
+		t.Error("Expected create_team not to require confirmation")
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// wrapDryRun returns a handler that runs next in dry-run mode when forced or
+// when the call sets dry_run. In dry-run mode the client skips write requests
+// and the tool reports the request it would have sent.
+func wrapDryRun(force bool, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if dryRun, _ := request.GetArguments()["dry_run"].(bool); force || dryRun {
+			ctx = client.WithDryRun(ctx)
+		}
+		return next(ctx, request)
+	}
+}
+
+// dryRunTools adds the dry_run argument to each named write tool that is still
+// registered. With force set, every call to those tools is a dry run.
+func dryRunTools(s *server.MCPServer, names []string, force bool) {
+	for _, name := range names {
+		registered := s.GetTool(name)
+		if registered == nil {
+			continue
+		}
+
+		tool := withArgument(registered.Tool, mcp.WithBoolean("dry_run",
+			mcp.Description("Validate the input and return the request that would be sent without changing anything in PagerDuty")))
+		s.AddTool(tool, wrapDryRun(force, registered.Handler))
+	}
+}
+
+// withArgument returns a copy of tool with an extra argument in its input schema
+func withArgument(tool mcp.Tool, opt mcp.ToolOption) mcp.Tool {
+	properties := make(map[string]any, len(tool.InputSchema.Properties)+1)
+	for k, v := range tool.InputSchema.Properties {
+		properties[k] = v
+	}
+	tool.InputSchema.Properties = properties
+	opt(&tool)
+	return tool
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// dryRunOutput is the dry-run result payload
+type dryRunOutput struct {
+	DryRun  bool `json:"dry_run"`
+	Request struct {
+		Method string         `json:"method"`
+		Path   string         `json:"path"`
+		Body   map[string]any `json:"body"`
+	} `json:"request"`
+}
+
+// newDryRunTestServer creates a server whose fake PagerDuty API fails the test on any write request
+func newDryRunTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected no write request, got %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// TestDryRun_Argument tests that dry_run returns the request payload without sending it
+func TestDryRun_Argument(t *testing.T) {
+	ts := newDryRunTestServer(t)
+	s := New(Config{EnableWriteTools: true}, client.NewClient(client.Config{APIKey: "test-api-key", APIHost: ts.URL}))
+
+	result := callWithArgs(t, s.GetTool("create_team").Handler, map[string]any{"name": "Platform", "dry_run": true})
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %s", resultText(result))
+	}
+
+	var out dryRunOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("Failed to parse dry-run output: %v", err)
+	}
+	if !out.DryRun || out.Request.Method != http.MethodPost || out.Request.Path != "/teams" {
+		t.Errorf("Expected a dry-run POST /teams, got %+v", out)
+	}
+	team, _ := out.Request.Body["team"].(map[string]any)
+	if team["name"] != "Platform" {
+		t.Errorf("Expected the team payload in the request body, got %v", out.Request.Body)
+	}
+}
+
+// TestDryRun_DestructiveSkipsConfirmation tests that a forced dry run of a destructive tool needs no confirmation token
+func TestDryRun_DestructiveSkipsConfirmation(t *testing.T) {
+	ts := newDryRunTestServer(t)
+	s := New(Config{EnableWriteTools: true, RequireConfirmation: true, DryRun: true}, client.NewClient(client.Config{APIKey: "test-api-key", APIHost: ts.URL}))
+
+	var request mcp.CallToolRequest
+	request.Params.Arguments = map[string]any{"team_id": "PTEAM1"}
+	result, err := s.GetTool("delete_team").Handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var out dryRunOutput
+	if err := json.Unmarshal([]byte(resultText(result)), &out); err != nil {
+		t.Fatalf("Expected dry-run output, got %q", resultText(result))
+	}
+	if out.Request.Method != http.MethodDelete || out.Request.Path != "/teams/PTEAM1" {
+		t.Errorf("Expected a dry-run DELETE /teams/PTEAM1, got %+v", out)
+	}
+}
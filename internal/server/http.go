@@ -1,12 +1,23 @@
 package server
 
 import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/auth"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/version"
+	"github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
 )
 
@@ -15,21 +26,53 @@ type HTTPConfig struct {
 	Host       string
 	Port       int
 	Authorizer auth.Authorizer
+
+	// ReadinessCheck verifies the server can reach PagerDuty. /ready reports
+	// 503 when it fails. When nil, /ready always reports ready.
+	ReadinessCheck func(ctx context.Context) error
+
+	// RateLimitPerMinute limits each caller, identified by its Authorization
+	// header, to this many requests per minute. Zero disables rate limiting.
+	RateLimitPerMinute int
+
+	// RateLimitBurst is how many requests a caller may make at once before
+	// the per-minute rate applies. Zero defaults to RateLimitPerMinute.
+	RateLimitBurst int
+
+	// EnableSessions issues an Mcp-Session-Id on initialize and requires it
+	// on every later request, as the streamable HTTP transport specifies
+	EnableSessions bool
 }
 
+// readinessCacheTTL is how long a readiness check result is reused
+const readinessCacheTTL = 5 * time.Second
+
+// readinessTimeout bounds a single readiness check
+const readinessTimeout = 5 * time.Second
+
 // HTTPServer wraps an MCP server with HTTP transport
 type HTTPServer struct {
 	mcpServer  *mcpserver.MCPServer
 	config     HTTPConfig
 	httpServer *http.Server
+
+	readyMu        sync.Mutex
+	readyCheckedAt time.Time
+	readyErr       error
+
+	sessions *sessionStore
 }
 
 // NewHTTPServer creates a new HTTP server wrapping the MCP server
 func NewHTTPServer(mcpServer *mcpserver.MCPServer, config HTTPConfig) *HTTPServer {
-	return &HTTPServer{
+	s := &HTTPServer{
 		mcpServer: mcpServer,
 		config:    config,
 	}
+	if config.EnableSessions {
+		s.sessions = newSessionStore()
+	}
+	return s
 }
 
 // healthResponse represents the health check response
@@ -38,26 +81,100 @@ type healthResponse struct {
 	Version string `json:"version"`
 }
 
-// RunHTTP starts the HTTP server
-func (s *HTTPServer) RunHTTP() error {
+// Handler builds the HTTP handler with all routes and the rate limit, auth,
+// and request ID middleware applied
+func (s *HTTPServer) Handler() http.Handler {
 	mux := http.NewServeMux()
 
 	// Health endpoint (no auth required)
 	mux.HandleFunc("/health", s.handleHealth)
 
+	// Readiness endpoint (no auth required)
+	mux.HandleFunc("/ready", s.handleReady)
+
+	// Tool discovery endpoint
+	mux.HandleFunc("/tools", s.handleTools)
+
 	// JSON-RPC endpoint
 	mux.HandleFunc("/", s.handleJSONRPC)
 
-	// Apply auth middleware
+	// Rate limiting runs after auth so only authorized callers use up a budget
 	var handler http.Handler = mux
+	if s.config.RateLimitPerMinute > 0 {
+		handler = newRateLimiter(s.config.RateLimitPerMinute, s.config.RateLimitBurst).middleware(handler)
+	}
+
+	// Apply auth middleware
 	if s.config.Authorizer != nil {
-		handler = auth.Middleware(s.config.Authorizer)(mux)
+		handler = auth.Middleware(s.config.Authorizer)(handler)
+	}
+
+	// The request ID is outermost so rejected requests are also traceable
+	return requestIDMiddleware(handler)
+}
+
+// RequestIDHeader is the header carrying the request ID in both directions
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds a caller-supplied request ID
+const maxRequestIDLength = 128
+
+// requestIDMiddleware reuses the caller's X-Request-ID or generates one,
+// attaches it to the request context, and echoes it on the response
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRandomID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(client.WithRequestID(r.Context(), id)))
+	})
+}
+
+// validRequestID reports whether a caller-supplied ID is safe to log and echo
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
 	}
+	for _, ch := range id {
+		if ch < '!' || ch > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// newRandomID returns a random 128-bit hex ID
+func newRandomID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// readyResponse represents the readiness check response
+type readyResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// toolInfo describes a registered tool in the /tools response
+type toolInfo struct {
+	Name  string `json:"name"`
+	Title string `json:"title,omitempty"`
+}
 
+// toolsResponse represents the /tools response
+type toolsResponse struct {
+	Tools []toolInfo `json:"tools"`
+}
+
+// RunHTTP starts the HTTP server
+func (s *HTTPServer) RunHTTP() error {
 	addr := fmt.Sprintf("%s:%d", s.config.Host, s.config.Port)
 	s.httpServer = &http.Server{
 		Addr:    addr,
-		Handler: handler,
+		Handler: s.Handler(),
 	}
 
 	fmt.Printf("Starting HTTP server on %s\n", addr)
@@ -73,7 +190,7 @@ func (s *HTTPServer) handleHealth(w http.ResponseWriter, r *http.Request) {
 
 	resp := healthResponse{
 		Status:  "ok",
-		Version: ServerVersion,
+		Version: version.Version,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -81,8 +198,75 @@ func (s *HTTPServer) handleHealth(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
-// handleJSONRPC handles the JSON-RPC endpoint at POST /
+// handleReady handles the /ready endpoint, reporting whether PagerDuty is reachable
+func (s *HTTPServer) handleReady(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, `{"error":"Method not allowed"}`, http.StatusMethodNotAllowed)
+		return
+	}
+
+	resp := readyResponse{Status: "ready"}
+	status := http.StatusOK
+	if err := s.checkReadiness(r.Context()); err != nil {
+		// The endpoint is unauthenticated, so the upstream error is only logged
+		resp = readyResponse{Status: "unavailable", Error: "upstream unavailable"}
+		status = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// checkReadiness runs the readiness check, reusing a recent result to avoid
+// calling the PagerDuty API on every probe
+func (s *HTTPServer) checkReadiness(ctx context.Context) error {
+	if s.config.ReadinessCheck == nil {
+		return nil
+	}
+
+	s.readyMu.Lock()
+	defer s.readyMu.Unlock()
+
+	if !s.readyCheckedAt.IsZero() && time.Since(s.readyCheckedAt) < readinessCacheTTL {
+		return s.readyErr
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+	s.readyErr = s.config.ReadinessCheck(ctx)
+	s.readyCheckedAt = time.Now()
+	if s.readyErr != nil {
+		log.Printf("Readiness check failed: %v", s.readyErr)
+	}
+	return s.readyErr
+}
+
+// handleTools handles the /tools endpoint, listing registered tool names and titles
+func (s *HTTPServer) handleTools(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, `{"error":"Method not allowed"}`, http.StatusMethodNotAllowed)
+		return
+	}
+
+	resp := toolsResponse{Tools: []toolInfo{}}
+	for name, tool := range s.mcpServer.ListTools() {
+		resp.Tools = append(resp.Tools, toolInfo{Name: name, Title: tool.Tool.Annotations.Title})
+	}
+	sort.Slice(resp.Tools, func(i, j int) bool { return resp.Tools[i].Name < resp.Tools[j].Name })
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// handleJSONRPC handles the JSON-RPC endpoint at POST /, and DELETE / to end
+// a session when sessions are enabled
 func (s *HTTPServer) handleJSONRPC(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodDelete && s.sessions != nil {
+		s.handleEndSession(w, r)
+		return
+	}
 	if r.Method != http.MethodPost {
 		http.Error(w, `{"error":"Method not allowed"}`, http.StatusMethodNotAllowed)
 		return
@@ -96,8 +280,41 @@ func (s *HTTPServer) handleJSONRPC(w http.ResponseWriter, r *http.Request) {
 	}
 	defer r.Body.Close()
 
-	// Process the JSON-RPC request through the MCP server
-	response := s.mcpServer.HandleMessage(r.Context(), body)
+	// An initialize starts a new session; everything else must name a live one
+	var sessionID string
+	if s.sessions != nil {
+		if containsInitialize(body) {
+			sessionID = s.sessions.create(callerKey(r))
+		} else {
+			sessionID = r.Header.Get(SessionHeader)
+			if sessionID == "" {
+				http.Error(w, `{"error":"Mcp-Session-Id header required"}`, http.StatusBadRequest)
+				return
+			}
+			if !s.sessions.touch(sessionID, callerKey(r)) {
+				http.Error(w, `{"error":"Session not found"}`, http.StatusNotFound)
+				return
+			}
+		}
+		w.Header().Set(SessionHeader, sessionID)
+	}
+
+	// Process the JSON-RPC request (or batch) through the MCP server
+	var response any
+	if isBatch(body) {
+		responses, err := s.handleBatch(r.Context(), body)
+		if err != nil {
+			response = mcp.NewJSONRPCError(mcp.NewRequestId(nil), mcp.INVALID_REQUEST, err.Error(), nil)
+		} else if len(responses) == 0 {
+			// A batch of only notifications gets no response body
+			w.WriteHeader(http.StatusAccepted)
+			return
+		} else {
+			response = responses
+		}
+	} else {
+		response = s.mcpServer.HandleMessage(r.Context(), body)
+	}
 
 	// Marshal the response to JSON
 	responseBytes, err := json.Marshal(response)
@@ -110,3 +327,43 @@ func (s *HTTPServer) handleJSONRPC(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	w.Write(responseBytes)
 }
+
+// handleEndSession handles DELETE /, terminating the caller's session
+func (s *HTTPServer) handleEndSession(w http.ResponseWriter, r *http.Request) {
+	sessionID := r.Header.Get(SessionHeader)
+	if sessionID == "" {
+		http.Error(w, `{"error":"Mcp-Session-Id header required"}`, http.StatusBadRequest)
+		return
+	}
+	if !s.sessions.remove(sessionID, callerKey(r)) {
+		http.Error(w, `{"error":"Session not found"}`, http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// isBatch reports whether the body is a JSON-RPC batch (a top-level array)
+func isBatch(body []byte) bool {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// handleBatch dispatches each message in a JSON-RPC batch and returns the
+// responses in request order. Notifications produce no response.
+func (s *HTTPServer) handleBatch(ctx context.Context, body []byte) ([]mcp.JSONRPCMessage, error) {
+	var messages []json.RawMessage
+	if err := json.Unmarshal(body, &messages); err != nil {
+		return nil, fmt.Errorf("invalid batch: %w", err)
+	}
+	if len(messages) == 0 {
+		return nil, fmt.Errorf("invalid batch: empty array")
+	}
+
+	responses := make([]mcp.JSONRPCMessage, 0, len(messages))
+	for _, message := range messages {
+		if response := s.mcpServer.HandleMessage(ctx, message); response != nil {
+			responses = append(responses, response)
+		}
+	}
+	return responses, nil
+}
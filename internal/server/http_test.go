@@ -2,7 +2,9 @@ package server
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/auth"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/version"
 )
 
 // createTestHandler creates an HTTP handler for testing without starting a real server
@@ -28,17 +31,8 @@ func createTestHandler(authorizer auth.Authorizer) http.Handler {
 		Authorizer: authorizer,
 	})
 
-	// Build the handler (same logic as RunHTTP but without starting the server)
-	mux := http.NewServeMux()
-	mux.HandleFunc("/health", httpServer.handleHealth)
-	mux.HandleFunc("/", httpServer.handleJSONRPC)
-
-	var handler http.Handler = mux
-	if authorizer != nil {
-		handler = auth.Middleware(authorizer)(mux)
-	}
-
-	return handler
+	// Build the handler (same routes as RunHTTP but without starting the server)
+	return httpServer.Handler()
 }
 
 // TestHTTPHealthEndpoint tests that GET /health returns 200 with proper JSON response
@@ -87,8 +81,36 @@ func TestHTTPHealthEndpoint(t *testing.T) {
 		t.Error("Expected version to be non-empty")
 	}
 
-	if healthResp.Version != ServerVersion {
-		t.Errorf("Expected version '%s', got '%s'", ServerVersion, healthResp.Version)
+	if healthResp.Version != version.Version {
+		t.Errorf("Expected version '%s', got '%s'", version.Version, healthResp.Version)
+	}
+}
+
+// TestHTTPHealthEndpoint_InjectedVersion tests that a build-time version override is reported by /health
+func TestHTTPHealthEndpoint_InjectedVersion(t *testing.T) {
+	original := version.Version
+	version.Version = "9.9.9-test"
+	defer func() { version.Version = original }()
+
+	handler := createTestHandler(&auth.MockAuthorizer{})
+
+	// Create test server
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/health")
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var healthResp healthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&healthResp); err != nil {
+		t.Fatalf("Failed to parse response JSON: %v", err)
+	}
+
+	if healthResp.Version != "9.9.9-test" {
+		t.Errorf("Expected version '9.9.9-test', got '%s'", healthResp.Version)
 	}
 }
 
@@ -240,8 +262,8 @@ func TestHTTPMCPInitialize(t *testing.T) {
 		t.Errorf("Expected server name '%s', got '%v'", ServerName, serverInfo["name"])
 	}
 
-	if serverInfo["version"] != ServerVersion {
-		t.Errorf("Expected server version '%s', got '%v'", ServerVersion, serverInfo["version"])
+	if serverInfo["version"] != version.Version {
+		t.Errorf("Expected server version '%s', got '%v'", version.Version, serverInfo["version"])
 	}
 
 	// Check for protocolVersion in result
@@ -380,3 +402,262 @@ func TestHTTPMCPToolsList(t *testing.T) {
 
 	t.Logf("Successfully retrieved %d tools", len(tools))
 }
+
+// TestHTTPToolsEndpoint tests that GET /tools lists registered tools with their titles
+func TestHTTPToolsEndpoint(t *testing.T) {
+	handler := createTestHandler(&auth.MockAuthorizer{})
+
+	// Create test server
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/tools", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer test-token")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var toolsResp toolsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&toolsResp); err != nil {
+		t.Fatalf("Failed to parse response JSON: %v", err)
+	}
+
+	titles := make(map[string]string)
+	for _, tool := range toolsResp.Tools {
+		titles[tool.Name] = tool.Title
+	}
+	if titles["list_incidents"] != "List Incidents" {
+		t.Errorf("Expected list_incidents with title 'List Incidents', got %v", toolsResp.Tools)
+	}
+	if _, ok := titles["list_services"]; !ok {
+		t.Error("Expected list_services to be listed")
+	}
+	if _, ok := titles["manage_incidents"]; ok {
+		t.Error("Expected write tools not to be listed when disabled")
+	}
+}
+
+// TestHTTPToolsEndpoint_RequiresAuth tests that GET /tools is behind the auth middleware
+func TestHTTPToolsEndpoint_RequiresAuth(t *testing.T) {
+	handler := createTestHandler(&auth.MockAuthorizer{})
+
+	// Create test server
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/tools")
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected status 401, got %d", resp.StatusCode)
+	}
+}
+
+// TestHTTPJSONRPCBatch tests that a batch request returns one response per request in order
+func TestHTTPJSONRPCBatch(t *testing.T) {
+	handler := createTestHandler(&auth.MockAuthorizer{})
+
+	// Create test server
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	batch := `[
+		{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test-client","version":"1.0.0"}}},
+		{"jsonrpc":"2.0","method":"notifications/initialized"},
+		{"jsonrpc":"2.0","id":"two","method":"tools/list","params":{}}
+	]`
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL+"/", bytes.NewReader([]byte(batch)))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer test-token")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read response body: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d. Body: %s", resp.StatusCode, string(body))
+	}
+
+	var responses []map[string]interface{}
+	if err := json.Unmarshal(body, &responses); err != nil {
+		t.Fatalf("Expected a JSON array of responses, got %s: %v", string(body), err)
+	}
+	if len(responses) != 2 {
+		t.Fatalf("Expected 2 responses, got %d: %s", len(responses), string(body))
+	}
+
+	if id, ok := responses[0]["id"].(float64); !ok || id != 1 {
+		t.Errorf("Expected first response id 1, got '%v'", responses[0]["id"])
+	}
+	if result, ok := responses[0]["result"].(map[string]interface{}); !ok || result["serverInfo"] == nil {
+		t.Errorf("Expected initialize result in first response, got '%v'", responses[0])
+	}
+
+	if responses[1]["id"] != "two" {
+		t.Errorf("Expected second response id 'two', got '%v'", responses[1]["id"])
+	}
+	if result, ok := responses[1]["result"].(map[string]interface{}); !ok || result["tools"] == nil {
+		t.Errorf("Expected tools/list result in second response, got '%v'", responses[1])
+	}
+}
+
+// TestHTTPJSONRPCBatch_Empty tests that an empty batch returns an invalid request error
+func TestHTTPJSONRPCBatch_Empty(t *testing.T) {
+	handler := createTestHandler(&auth.MockAuthorizer{})
+
+	// Create test server
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL+"/", bytes.NewReader([]byte(`[]`)))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer test-token")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var response map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		t.Fatalf("Failed to parse response JSON: %v", err)
+	}
+	errObj, ok := response["error"].(map[string]interface{})
+	if !ok || errObj["code"] != float64(-32600) {
+		t.Errorf("Expected invalid request error, got '%v'", response)
+	}
+}
+
+// getReady sends GET /ready to a handler built with the given readiness check
+func getReady(t *testing.T, httpServer *HTTPServer) (int, readyResponse) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	httpServer.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+	var readyResp readyResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &readyResp); err != nil {
+		t.Fatalf("Failed to parse response JSON: %v", err)
+	}
+	return rec.Code, readyResp
+}
+
+// TestHTTPReadyEndpoint tests that /ready reports 200 without auth when the readiness check passes
+func TestHTTPReadyEndpoint(t *testing.T) {
+	calls := 0
+	httpServer := NewHTTPServer(New(Config{}, newTestPDClient()), HTTPConfig{
+		Authorizer: &auth.MockAuthorizer{},
+		ReadinessCheck: func(ctx context.Context) error {
+			calls++
+			return nil
+		},
+	})
+
+	code, readyResp := getReady(t, httpServer)
+	if code != http.StatusOK || readyResp.Status != "ready" {
+		t.Errorf("Expected 200 ready, got %d %+v", code, readyResp)
+	}
+
+	// A second probe within the cache window reuses the result
+	getReady(t, httpServer)
+	if calls != 1 {
+		t.Errorf("Expected readiness check to be cached, got %d call(s)", calls)
+	}
+}
+
+// TestHTTPReadyEndpoint_Unavailable tests that /ready reports 503 without the
+// upstream error detail when PagerDuty is unreachable
+func TestHTTPReadyEndpoint_Unavailable(t *testing.T) {
+	httpServer := NewHTTPServer(New(Config{}, newTestPDClient()), HTTPConfig{
+		ReadinessCheck: func(ctx context.Context) error {
+			return errors.New("API error (status 401): invalid token")
+		},
+	})
+
+	code, readyResp := getReady(t, httpServer)
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status 503, got %d", code)
+	}
+	if readyResp.Status != "unavailable" || readyResp.Error != "upstream unavailable" {
+		t.Errorf("Expected unavailable status with a generic error, got %+v", readyResp)
+	}
+}
+
+// TestHTTPRequestID_Echoed tests that a caller-supplied X-Request-ID is echoed on the response
+func TestHTTPRequestID_Echoed(t *testing.T) {
+	ts := httptest.NewServer(createTestHandler(&auth.MockAuthorizer{}))
+	defer ts.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/health", nil)
+	req.Header.Set(RequestIDHeader, "trace-abc-123")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if got := resp.Header.Get(RequestIDHeader); got != "trace-abc-123" {
+		t.Errorf("Expected request ID 'trace-abc-123', got '%s'", got)
+	}
+}
+
+// TestHTTPRequestID_Generated tests that a request ID is generated when none is supplied, including for rejected requests
+func TestHTTPRequestID_Generated(t *testing.T) {
+	ts := httptest.NewServer(createTestHandler(&auth.MockAuthorizer{}))
+	defer ts.Close()
+
+	// No Authorization header, so the auth middleware rejects the request
+	resp, err := http.Post(ts.URL+"/", "application/json", bytes.NewBufferString(`{}`))
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected status 401, got %d", resp.StatusCode)
+	}
+	if got := resp.Header.Get(RequestIDHeader); len(got) != 32 {
+		t.Errorf("Expected a generated 32-character request ID, got '%s'", got)
+	}
+}
+
+// TestRequestIDMiddleware_Context tests that the request ID is attached to the request context
+func TestRequestIDMiddleware_Context(t *testing.T) {
+	var got string
+	handler := requestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = client.RequestID(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.Header.Set(RequestIDHeader, "trace-abc-123")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if got != "trace-abc-123" {
+		t.Errorf("Expected request ID 'trace-abc-123' in context, got '%s'", got)
+	}
+}
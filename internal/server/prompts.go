@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// registerPrompts registers prompt templates for the common workflows described
+// in MCPServerInstructions
+func registerPrompts(s *server.MCPServer) {
+	// investigate_incident
+	s.AddPrompt(mcp.NewPrompt("investigate_incident",
+		mcp.WithPromptDescription("Investigate an incident: gather its details, notes, similar past incidents, related incidents, and recent changes"),
+		mcp.WithArgument("incident_id", mcp.RequiredArgument(), mcp.ArgumentDescription("The incident ID to investigate (e.g., 'PABC123')")),
+	), investigateIncidentPrompt)
+
+	// who_is_oncall
+	s.AddPrompt(mcp.NewPrompt("who_is_oncall",
+		mcp.WithPromptDescription("Find who is currently on call for a service or escalation policy"),
+		mcp.WithArgument("service_id", mcp.ArgumentDescription("The service ID (e.g., 'PDSVC123')")),
+		mcp.WithArgument("escalation_policy_id", mcp.ArgumentDescription("The escalation policy ID (e.g., 'PESCPOL123'). Used when service_id is not given.")),
+	), whoIsOncallPrompt)
+
+	// announce_maintenance
+	s.AddPrompt(mcp.NewPrompt("announce_maintenance",
+		mcp.WithPromptDescription("Announce planned maintenance on a public status page (requires write tools)"),
+		mcp.WithArgument("title", mcp.RequiredArgument(), mcp.ArgumentDescription("Public-facing title for the maintenance")),
+		mcp.WithArgument("starts_at", mcp.RequiredArgument(), mcp.ArgumentDescription("Start time in ISO 8601 format (e.g., '2024-01-15T09:00:00Z')")),
+		mcp.WithArgument("ends_at", mcp.RequiredArgument(), mcp.ArgumentDescription("Expected end time in ISO 8601 format (e.g., '2024-01-15T11:00:00Z')")),
+		mcp.WithArgument("status_page_id", mcp.ArgumentDescription("The status page ID. If omitted, the status pages are listed first.")),
+	), announceMaintenancePrompt)
+}
+
+func investigateIncidentPrompt(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	incidentID := strings.TrimSpace(request.Params.Arguments["incident_id"])
+	if incidentID == "" {
+		return nil, fmt.Errorf("incident_id is required")
+	}
+
+	text := fmt.Sprintf(`Investigate PagerDuty incident %[1]s and summarize what is known.
+
+1. Call get_incident with incident_id %[1]s for its status, urgency, service, and assignments.
+2. Call list_incident_notes with incident_id %[1]s to see what responders have found so far.
+3. Call get_past_incidents with incident_id %[1]s to find similar historical incidents and how they were resolved.
+4. Call get_related_incidents with incident_id %[1]s to check for other ongoing incidents that may share a cause.
+5. Call list_incident_change_events with incident_id %[1]s to find recent deployments or changes that may have caused it.
+
+Finish with a short summary: current state, who is working on it, likely cause, and suggested next steps. Do not change the incident without asking me first.`, incidentID)
+
+	return mcp.NewGetPromptResult("Investigate incident "+incidentID, []mcp.PromptMessage{
+		mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text)),
+	}), nil
+}
+
+func whoIsOncallPrompt(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	serviceID := strings.TrimSpace(request.Params.Arguments["service_id"])
+	policyID := strings.TrimSpace(request.Params.Arguments["escalation_policy_id"])
+
+	var target, lookup string
+	switch {
+	case serviceID != "":
+		target = "service " + serviceID
+		lookup = "Call who_is_oncall with service_id " + serviceID + "."
+	case policyID != "":
+		target = "escalation policy " + policyID
+		lookup = "Call who_is_oncall with escalation_policy_id " + policyID + "."
+	default:
+		target = "a service"
+		lookup = "Ask me which service I mean, then use list_services with a query to find its ID and call who_is_oncall with that service_id."
+	}
+
+	text := fmt.Sprintf(`Find out who is currently on call for %s.
+
+1. %s
+2. If I need more detail (on-call windows or schedules), call list_oncalls filtered by the same escalation policy.
+
+Reply with each escalation level and the person on call at that level.`, target, lookup)
+
+	return mcp.NewGetPromptResult("Who is on call for "+target, []mcp.PromptMessage{
+		mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text)),
+	}), nil
+}
+
+func announceMaintenancePrompt(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	args := request.Params.Arguments
+	title := strings.TrimSpace(args["title"])
+	startsAt := strings.TrimSpace(args["starts_at"])
+	endsAt := strings.TrimSpace(args["ends_at"])
+	if title == "" || startsAt == "" || endsAt == "" {
+		return nil, fmt.Errorf("title, starts_at, and ends_at are required")
+	}
+
+	pageStep := "Call list_status_pages and ask me which status page to use."
+	if pageID := strings.TrimSpace(args["status_page_id"]); pageID != "" {
+		pageStep = "Use status page " + pageID + "."
+	}
+
+	text := fmt.Sprintf(`Announce planned maintenance "%s" from %s to %s on a public status page.
+
+1. %s
+2. Call list_status_page_statuses for that status page and pick the status that represents scheduled maintenance.
+3. Show me the post you intend to publish and wait for my confirmation, since it is publicly visible.
+4. Call create_status_page_post with post_type "maintenance", the title, starts_at, ends_at, and the chosen status_id.
+5. Later, use create_status_page_post_update to report progress or completion.`, title, startsAt, endsAt, pageStep)
+
+	return mcp.NewGetPromptResult("Announce maintenance: "+title, []mcp.PromptMessage{
+		mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text)),
+	}), nil
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestInvestigateIncidentPrompt tests that the incident ID is filled into the investigation steps
+func TestInvestigateIncidentPrompt(t *testing.T) {
+	var request mcp.GetPromptRequest
+	request.Params.Arguments = map[string]string{"incident_id": "PABC123"}
+
+	result, err := investigateIncidentPrompt(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.Messages) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(result.Messages))
+	}
+
+	text := result.Messages[0].Content.(mcp.TextContent).Text
+	for _, want := range []string{"get_incident with incident_id PABC123", "get_past_incidents", "list_incident_change_events"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected prompt to contain '%s', got: %s", want, text)
+		}
+	}
+}
+
+// TestInvestigateIncidentPrompt_MissingID tests that incident_id is required
+func TestInvestigateIncidentPrompt_MissingID(t *testing.T) {
+	if _, err := investigateIncidentPrompt(context.Background(), mcp.GetPromptRequest{}); err == nil {
+		t.Error("Expected error for missing incident_id, got nil")
+	}
+}
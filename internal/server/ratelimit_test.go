@@ -0,0 +1,65 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/auth"
+)
+
+// TestHTTPRateLimit tests that the request after the burst is rejected with 429 and Retry-After, per token
+func TestHTTPRateLimit(t *testing.T) {
+	httpServer := NewHTTPServer(New(Config{}, newTestPDClient()), HTTPConfig{
+		Authorizer:         &auth.MockAuthorizer{},
+		RateLimitPerMinute: 3,
+	})
+	handler := httpServer.Handler()
+
+	get := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/tools", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i := 0; i < 3; i++ {
+		if rec := get("alice"); rec.Code != http.StatusOK {
+			t.Fatalf("Expected request %d to succeed, got %d", i+1, rec.Code)
+		}
+	}
+
+	rec := get("alice")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected status 429, got %d", rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "20" {
+		t.Errorf("Expected Retry-After '20', got '%s'", got)
+	}
+
+	// Another token has its own budget
+	if rec := get("bob"); rec.Code != http.StatusOK {
+		t.Errorf("Expected a different token to be allowed, got %d", rec.Code)
+	}
+}
+
+// TestRateLimiter_Refill tests that a bucket refills at the configured rate
+func TestRateLimiter_Refill(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(60, 1)
+	limiter.now = func() time.Time { return now }
+
+	if ok, _ := limiter.allow("alice"); !ok {
+		t.Fatal("Expected the first request to be allowed")
+	}
+	if ok, wait := limiter.allow("alice"); ok || wait != time.Second {
+		t.Fatalf("Expected the second request to wait 1s, got allowed=%v wait=%v", ok, wait)
+	}
+
+	now = now.Add(time.Second)
+	if ok, _ := limiter.allow("alice"); !ok {
+		t.Error("Expected a request to be allowed after the bucket refilled")
+	}
+}
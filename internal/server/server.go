@@ -1,15 +1,20 @@
 package server
 
 import (
+	"fmt"
+	"io"
+	"strings"
+
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/tools"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/version"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
-const (
-	ServerName    = "PagerDuty MCP Server"
-	ServerVersion = "0.1.0"
-)
+// ServerName is the name reported in the MCP initialize response. The version
+// comes from the version package and can be set at build time.
+const ServerName = "PagerDuty MCP Server"
 
 const MCPServerInstructions = `# PagerDuty MCP Server
 
@@ -56,12 +61,27 @@ All list_* and get_* tools are read-only and safe to use without confirmation.
 - update_* tools modify existing resources
 - manage_incidents can change incident status, urgency, and assignments
 - add_* tools add relationships (responders, team members, notes)
+- send_event and send_change_event send events to an integration using its routing key
 
 ### Destructive Tools (REQUIRES USER CONFIRMATION)
 The following tools permanently delete data and should ALWAYS be confirmed with the user:
 - delete_team: Permanently removes a team
+- delete_service: Permanently removes a service and its incident history
+- remove_service_team: Removes a team's ownership of a service
 - delete_alert_grouping_setting: Permanently removes an alert grouping configuration
 - remove_team_member: Removes a user from a team
+- remove_escalation_target: Stops a user or schedule being notified at an escalation level
+- delete_ruleset_rule: Permanently removes an event rule from a legacy ruleset
+- delete_extension: Permanently removes an extension, so its services stop notifying the external system
+- delete_addon: Permanently removes an add-on from the PagerDuty UI
+- remove_incident_subscribers: Stops stakeholders receiving incident status updates
+- rotate_event_orchestration_integration_key: Revokes the old routing key, so senders must switch to the new one
+
+Any write tool accepts dry_run=true to validate its input and return the request it would send
+without changing anything. Dry runs of destructive tools need no confirmation.
+
+If a destructive tool responds with CONFIRMATION REQUIRED, it has not run. Confirm the action
+with the user, then call it again with the same arguments and the returned token as confirm.
 
 ## Common Workflow Patterns
 
@@ -73,41 +93,101 @@ The following tools permanently delete data and should ALWAYS be confirmed with
 5. get_related_incidents to see potentially related ongoing incidents
 6. list_incident_change_events to see recent deployments that may have caused it
 
+### Finding a Resource by Name
+Use search with a name fragment when you don't know whether it is a service, team, user,
+schedule, escalation policy, or incident.
+
 ### Finding Who is On-Call
-1. list_oncalls with schedule_ids or escalation_policy_ids
-2. Or list_schedule_users with a date range
+1. who_is_oncall with a service_id or escalation_policy_id for the current responders
+2. list_oncalls with schedule_ids or escalation_policy_ids for full detail
+3. Or list_schedule_users with a date range
 
 ### Responding to an Incident
-1. manage_incidents to acknowledge or resolve
+1. acknowledge_incident / resolve_incident for a single incident, or manage_incidents for several
 2. add_note_to_incident to document findings
 3. add_responders to bring in additional help
 
 ### Understanding Service Health
 1. list_services to find the service
-2. list_incidents filtered by service_id to see recent incidents
-3. list_service_change_events to see recent deployments`
+2. get_service_health for its status, open incidents, and recent changes in one call
+3. list_incidents filtered by service_id or list_service_change_events for more detail`
 
 // Config holds the server configuration
 type Config struct {
+	// EnableWriteTools is the master switch for write tools
 	EnableWriteTools bool
+
+	// WriteCategories limits write tools to the listed categories (e.g.
+	// CategoryIncidents) when EnableWriteTools is set. Nil enables all categories.
+	WriteCategories map[string]bool
+
+	// AllowedTools, when non-empty, limits the server to the named tools
+	AllowedTools []string
+
+	// DeniedTools removes the named tools. Denied tools are removed even if
+	// they are also allowed.
+	DeniedTools []string
+
+	// RequireConfirmation makes DestructiveTools a two-step operation: the
+	// first call returns a confirmation token and the action only runs when
+	// called again with that token in the confirm argument
+	RequireConfirmation bool
+
+	// DryRun makes every write tool call a dry run: inputs are validated and
+	// the request that would be sent is returned, but nothing is changed.
+	// Without it, callers can still request a dry run with the dry_run argument.
+	DryRun bool
+
+	// AuditLog, when set, receives one JSON line per write tool invocation
+	// with the tool name, redacted arguments, caller, and outcome
+	AuditLog io.Writer
 }
 
 // New creates a new MCP server with the given configuration
 func New(cfg Config, pdClient *client.Client) *server.MCPServer {
 	s := server.NewMCPServer(
 		ServerName,
-		ServerVersion,
+		version.Version,
 		server.WithInstructions(MCPServerInstructions),
+		server.WithResourceCapabilities(false, false),
+		server.WithPromptCapabilities(false),
+		// Advertise listChanged so adding or removing a tool after startup
+		// sends notifications/tools/list_changed to connected clients
+		server.WithToolCapabilities(true),
 	)
 
+	// Register prompts for common workflows
+	registerPrompts(s)
+
+	// Register resources (read-only, always enabled)
+	tools.RegisterResources(s, pdClient)
+
 	// Register read-only tools (always enabled)
 	registerReadTools(s, pdClient)
 
 	// Register write tools (only if enabled)
+	var writeTools []string
 	if cfg.EnableWriteTools {
-		registerWriteTools(s, pdClient)
+		writeTools = registerWriteTools(s, pdClient, cfg)
+	}
+
+	// Apply the tool allowlist and denylist
+	filterTools(s, cfg)
+
+	// Record write tool invocations in the audit log
+	if cfg.AuditLog != nil {
+		auditTools(s, newAuditLogger(cfg.AuditLog), writeTools)
 	}
 
+	// Require a confirmation round trip for destructive tools
+	if cfg.RequireConfirmation {
+		requireConfirmation(s, newConfirmationStore(confirmationTTL))
+	}
+
+	// Let write tools preview their requests; the dry-run context must be set
+	// before confirmation and auditing see the call
+	dryRunTools(s, writeTools, cfg.DryRun)
+
 	return s
 }
 
@@ -137,9 +217,18 @@ func registerReadTools(s *server.MCPServer, c *client.Client) {
 	// Event Orchestrations
 	tools.RegisterEventOrchestrationReadTools(s, c)
 
+	// Rulesets (legacy event rules)
+	tools.RegisterRulesetReadTools(s, c)
+
 	// Incident Workflows
 	tools.RegisterIncidentWorkflowReadTools(s, c)
 
+	// Extensions
+	tools.RegisterExtensionReadTools(s, c)
+
+	// Add-ons
+	tools.RegisterAddonReadTools(s, c)
+
 	// Change Events
 	tools.RegisterChangeEventReadTools(s, c)
 
@@ -148,31 +237,136 @@ func registerReadTools(s *server.MCPServer, c *client.Client) {
 
 	// Status Pages
 	tools.RegisterStatusPageReadTools(s, c)
+
+	// Webhook Subscriptions
+	tools.RegisterWebhookSubscriptionReadTools(s, c)
+
+	// Search
+	tools.RegisterSearchReadTools(s, c)
 }
 
-// registerWriteTools registers all write tools
-func registerWriteTools(s *server.MCPServer, c *client.Client) {
-	// Incidents
-	tools.RegisterIncidentWriteTools(s, c)
+// Write tool categories accepted in Config.WriteCategories
+const (
+	CategoryIncidents           = "incidents"
+	CategoryServices            = "services"
+	CategoryTeams               = "teams"
+	CategorySchedules           = "schedules"
+	CategoryEscalationPolicies  = "escalation_policies"
+	CategoryEventOrchestrations = "event_orchestrations"
+	CategoryRulesets            = "rulesets"
+	CategoryIncidentWorkflows   = "incident_workflows"
+	CategoryExtensions          = "extensions"
+	CategoryAddons              = "addons"
+	CategoryAlertGrouping       = "alert_grouping"
+	CategoryStatusPages         = "status_pages"
+	CategoryWebhooks            = "webhooks"
+	CategoryEvents              = "events"
+)
 
-	// Services
-	tools.RegisterServiceWriteTools(s, c)
+// writeToolCategories maps each write category to its registration function
+var writeToolCategories = []struct {
+	name     string
+	register func(*server.MCPServer, *client.Client)
+}{
+	{CategoryIncidents, tools.RegisterIncidentWriteTools},
+	{CategoryServices, tools.RegisterServiceWriteTools},
+	{CategoryTeams, tools.RegisterTeamWriteTools},
+	{CategorySchedules, tools.RegisterScheduleWriteTools},
+	{CategoryEscalationPolicies, tools.RegisterEscalationPolicyWriteTools},
+	{CategoryEventOrchestrations, tools.RegisterEventOrchestrationWriteTools},
+	{CategoryRulesets, tools.RegisterRulesetWriteTools},
+	{CategoryIncidentWorkflows, tools.RegisterIncidentWorkflowWriteTools},
+	{CategoryExtensions, tools.RegisterExtensionWriteTools},
+	{CategoryAddons, tools.RegisterAddonWriteTools},
+	{CategoryAlertGrouping, tools.RegisterAlertGroupingWriteTools},
+	{CategoryStatusPages, tools.RegisterStatusPageWriteTools},
+	{CategoryWebhooks, tools.RegisterWebhookSubscriptionWriteTools},
+	{CategoryEvents, tools.RegisterEventWriteTools},
+}
 
-	// Teams
-	tools.RegisterTeamWriteTools(s, c)
+// ParseWriteCategories parses a comma-separated list of write categories
+func ParseWriteCategories(value string) (map[string]bool, error) {
+	categories := make(map[string]bool)
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		known := false
+		for _, category := range writeToolCategories {
+			if category.name == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown write category '%s'", name)
+		}
+		categories[name] = true
+	}
+	return categories, nil
+}
 
-	// Schedules
-	tools.RegisterScheduleWriteTools(s, c)
+// writeCategoryEnabled reports whether write tools in the category should be registered
+func (cfg Config) writeCategoryEnabled(category string) bool {
+	if !cfg.EnableWriteTools {
+		return false
+	}
+	if cfg.WriteCategories == nil {
+		return true
+	}
+	return cfg.WriteCategories[category]
+}
 
-	// Event Orchestrations
-	tools.RegisterEventOrchestrationWriteTools(s, c)
+// registerWriteTools registers the write tools for each enabled category and
+// returns the names of the tools it added
+func registerWriteTools(s *server.MCPServer, c *client.Client, cfg Config) []string {
+	existing := s.ListTools()
+	for _, category := range writeToolCategories {
+		if cfg.writeCategoryEnabled(category.name) {
+			category.register(s, c)
+		}
+	}
 
-	// Incident Workflows
-	tools.RegisterIncidentWorkflowWriteTools(s, c)
+	var names []string
+	for name := range s.ListTools() {
+		if _, ok := existing[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
 
-	// Alert Grouping Settings
-	tools.RegisterAlertGroupingWriteTools(s, c)
+// NotifyToolListChanged sends notifications/tools/list_changed to every
+// initialized client session. AddTool and DeleteTools already send it; call
+// this after changing a tool in some other way, such as toggling a category
+// whose handlers check their enablement at call time.
+func NotifyToolListChanged(s *server.MCPServer) {
+	s.SendNotificationToAllClients(mcp.MethodNotificationToolsListChanged, nil)
+}
 
-	// Status Pages
-	tools.RegisterStatusPageWriteTools(s, c)
+// filterTools removes registered tools excluded by the allowlist or denylist
+func filterTools(s *server.MCPServer, cfg Config) {
+	if len(cfg.AllowedTools) == 0 && len(cfg.DeniedTools) == 0 {
+		return
+	}
+
+	allowed := make(map[string]bool, len(cfg.AllowedTools))
+	for _, name := range cfg.AllowedTools {
+		allowed[name] = true
+	}
+	denied := make(map[string]bool, len(cfg.DeniedTools))
+	for _, name := range cfg.DeniedTools {
+		denied[name] = true
+	}
+
+	var remove []string
+	for name := range s.ListTools() {
+		if denied[name] || (len(allowed) > 0 && !allowed[name]) {
+			remove = append(remove, name)
+		}
+	}
+	if len(remove) > 0 {
+		s.DeleteTools(remove...)
+	}
 }
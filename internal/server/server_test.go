@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// newTestPDClient creates a PagerDuty client for tests that don't call the API
+func newTestPDClient() *client.Client {
+	return client.NewClient(client.Config{APIKey: "test-api-key"})
+}
+
+// newTestServerTools creates an MCP server with the given config and returns its registered tool names
+func newTestServerTools(t *testing.T, cfg Config) map[string]bool {
+	t.Helper()
+	s := New(cfg, newTestPDClient())
+
+	names := make(map[string]bool)
+	for name := range s.ListTools() {
+		names[name] = true
+	}
+	return names
+}
+
+// TestNew_WriteCategories tests that only the enabled categories' write tools are registered
+func TestNew_WriteCategories(t *testing.T) {
+	names := newTestServerTools(t, Config{
+		EnableWriteTools: true,
+		WriteCategories:  map[string]bool{CategoryIncidents: true},
+	})
+
+	for _, name := range []string{"manage_incidents", "create_incident", "list_incidents", "list_teams"} {
+		if !names[name] {
+			t.Errorf("Expected tool '%s' to be registered", name)
+		}
+	}
+	for _, name := range []string{"delete_team", "create_service", "create_schedule_override"} {
+		if names[name] {
+			t.Errorf("Expected tool '%s' not to be registered", name)
+		}
+	}
+}
+
+// TestNew_WriteCategoriesRequireMasterSwitch tests that categories have no effect without EnableWriteTools
+func TestNew_WriteCategoriesRequireMasterSwitch(t *testing.T) {
+	names := newTestServerTools(t, Config{
+		WriteCategories: map[string]bool{CategoryIncidents: true},
+	})
+
+	if names["manage_incidents"] {
+		t.Error("Expected write tools to be disabled without EnableWriteTools")
+	}
+}
+
+// TestNew_AllWriteCategories tests that a nil category set enables every write category
+func TestNew_AllWriteCategories(t *testing.T) {
+	names := newTestServerTools(t, Config{EnableWriteTools: true})
+
+	for _, name := range []string{"manage_incidents", "delete_team", "create_service"} {
+		if !names[name] {
+			t.Errorf("Expected tool '%s' to be registered", name)
+		}
+	}
+}
+
+// TestParseWriteCategories tests parsing and validation of category lists
+func TestParseWriteCategories(t *testing.T) {
+	categories, err := ParseWriteCategories("incidents, schedules")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(categories) != 2 || !categories[CategoryIncidents] || !categories[CategorySchedules] {
+		t.Errorf("Expected incidents and schedules, got %v", categories)
+	}
+
+	if _, err := ParseWriteCategories("incidents,bogus"); err == nil {
+		t.Error("Expected error for unknown category, got nil")
+	}
+}
+
+// TestNew_DeniedTools tests that a denied tool is absent even when it is also allowed
+func TestNew_DeniedTools(t *testing.T) {
+	names := newTestServerTools(t, Config{
+		EnableWriteTools: true,
+		AllowedTools:     []string{"list_incidents", "get_incident", "delete_team"},
+		DeniedTools:      []string{"delete_team"},
+	})
+
+	if len(names) != 2 || !names["list_incidents"] || !names["get_incident"] {
+		t.Errorf("Expected only list_incidents and get_incident, got %v", names)
+	}
+}
+
+// TestNew_DeniedToolsOnly tests that the denylist alone removes just the denied tools
+func TestNew_DeniedToolsOnly(t *testing.T) {
+	names := newTestServerTools(t, Config{DeniedTools: []string{"list_users"}})
+
+	if names["list_users"] {
+		t.Error("Expected list_users to be removed")
+	}
+	if !names["list_incidents"] {
+		t.Error("Expected list_incidents to remain registered")
+	}
+}
+
+// fakeClientSession is an initialized client session that captures notifications
+type fakeClientSession struct {
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (f *fakeClientSession) SessionID() string { return "test-session" }
+func (f *fakeClientSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return f.notifications
+}
+func (f *fakeClientSession) Initialize()       {}
+func (f *fakeClientSession) Initialized() bool { return true }
+
+// TestToolListChanged tests that removing a tool and NotifyToolListChanged both notify connected clients
+func TestToolListChanged(t *testing.T) {
+	s := New(Config{}, newTestPDClient())
+	session := &fakeClientSession{notifications: make(chan mcp.JSONRPCNotification, 2)}
+	if err := s.RegisterSession(context.Background(), session); err != nil {
+		t.Fatalf("Failed to register session: %v", err)
+	}
+
+	s.DeleteTools("list_teams")
+	NotifyToolListChanged(s)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case n := <-session.notifications:
+			if n.Method != mcp.MethodNotificationToolsListChanged {
+				t.Errorf("Expected %s, got %s", mcp.MethodNotificationToolsListChanged, n.Method)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected notification %d, got none", i+1)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// SessionHeader carries the MCP session ID issued on initialize
+const SessionHeader = "Mcp-Session-Id"
+
+// sessionIdleTimeout is how long an unused session stays valid
+const sessionIdleTimeout = time.Hour
+
+// httpSession is the state kept for an initialized MCP session
+type httpSession struct {
+	caller   string
+	lastSeen time.Time
+}
+
+// sessionStore tracks sessions issued by the HTTP transport
+type sessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]*httpSession
+	now      func() time.Time
+}
+
+// newSessionStore creates an empty session store
+func newSessionStore() *sessionStore {
+	return &sessionStore{sessions: make(map[string]*httpSession), now: time.Now}
+}
+
+// create issues a new session for the caller and returns its ID
+func (s *sessionStore) create(caller string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.pruneLocked(now)
+	id := newRandomID()
+	s.sessions[id] = &httpSession{caller: caller, lastSeen: now}
+	return id
+}
+
+// touch reports whether id is a live session belonging to caller, and
+// extends it when it is
+func (s *sessionStore) touch(id, caller string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	session, ok := s.sessions[id]
+	if !ok || session.caller != caller {
+		return false
+	}
+	if now.Sub(session.lastSeen) > sessionIdleTimeout {
+		delete(s.sessions, id)
+		return false
+	}
+	session.lastSeen = now
+	return true
+}
+
+// remove ends the session, reporting whether it existed for the caller
+func (s *sessionStore) remove(id, caller string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok || session.caller != caller {
+		return false
+	}
+	delete(s.sessions, id)
+	return true
+}
+
+// pruneLocked drops idle sessions. s.mu must be held.
+func (s *sessionStore) pruneLocked(now time.Time) {
+	for id, session := range s.sessions {
+		if now.Sub(session.lastSeen) > sessionIdleTimeout {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+// containsInitialize reports whether the JSON-RPC message or batch includes
+// an initialize request
+func containsInitialize(body []byte) bool {
+	type message struct {
+		Method string `json:"method"`
+	}
+	if isBatch(body) {
+		var messages []message
+		if err := json.Unmarshal(body, &messages); err != nil {
+			return false
+		}
+		for _, m := range messages {
+			if m.Method == "initialize" {
+				return true
+			}
+		}
+		return false
+	}
+	var m message
+	return json.Unmarshal(body, &m) == nil && m.Method == "initialize"
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/auth"
+)
+
+const sessionInitializeBody = `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test-client","version":"1.0.0"}}}`
+
+// newSessionTestHandler returns an HTTP handler with sessions enabled
+func newSessionTestHandler() http.Handler {
+	return NewHTTPServer(New(Config{}, newTestPDClient()), HTTPConfig{
+		Authorizer:     &auth.MockAuthorizer{},
+		EnableSessions: true,
+	}).Handler()
+}
+
+// sendSessionRequest sends a JSON-RPC request with the given session ID (none when empty)
+func sendSessionRequest(handler http.Handler, method, body, token, sessionID string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	if sessionID != "" {
+		req.Header.Set(SessionHeader, sessionID)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// TestHTTPSession_InitializeThenCall tests that initialize issues a session ID that later calls can use
+func TestHTTPSession_InitializeThenCall(t *testing.T) {
+	handler := newSessionTestHandler()
+
+	rec := sendSessionRequest(handler, http.MethodPost, sessionInitializeBody, "alice", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected initialize to succeed, got %d: %s", rec.Code, rec.Body.String())
+	}
+	sessionID := rec.Header().Get(SessionHeader)
+	if sessionID == "" {
+		t.Fatal("Expected initialize to return an Mcp-Session-Id")
+	}
+
+	rec = sendSessionRequest(handler, http.MethodPost, `{"jsonrpc":"2.0","id":2,"method":"tools/list"}`, "alice", sessionID)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected tools/list with the session to succeed, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if got := rec.Header().Get(SessionHeader); got != sessionID {
+		t.Errorf("Expected the session ID to be echoed, got '%s'", got)
+	}
+}
+
+// TestHTTPSession_MissingHeader tests that a call without Mcp-Session-Id is rejected with 400
+func TestHTTPSession_MissingHeader(t *testing.T) {
+	handler := newSessionTestHandler()
+	sendSessionRequest(handler, http.MethodPost, sessionInitializeBody, "alice", "")
+
+	rec := sendSessionRequest(handler, http.MethodPost, `{"jsonrpc":"2.0","id":2,"method":"tools/list"}`, "alice", "")
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", rec.Code)
+	}
+}
+
+// TestHTTPSession_UnknownOrForeign tests that unknown sessions and another caller's session are rejected with 404
+func TestHTTPSession_UnknownOrForeign(t *testing.T) {
+	handler := newSessionTestHandler()
+	sessionID := sendSessionRequest(handler, http.MethodPost, sessionInitializeBody, "alice", "").Header().Get(SessionHeader)
+
+	listBody := `{"jsonrpc":"2.0","id":2,"method":"tools/list"}`
+	if rec := sendSessionRequest(handler, http.MethodPost, listBody, "alice", "not-a-session"); rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404 for an unknown session, got %d", rec.Code)
+	}
+	if rec := sendSessionRequest(handler, http.MethodPost, listBody, "bob", sessionID); rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404 for another caller's session, got %d", rec.Code)
+	}
+}
+
+// TestHTTPSession_Delete tests that DELETE ends the session so it can no longer be used
+func TestHTTPSession_Delete(t *testing.T) {
+	handler := newSessionTestHandler()
+	sessionID := sendSessionRequest(handler, http.MethodPost, sessionInitializeBody, "alice", "").Header().Get(SessionHeader)
+
+	if rec := sendSessionRequest(handler, http.MethodDelete, "", "alice", sessionID); rec.Code != http.StatusNoContent {
+		t.Fatalf("Expected status 204, got %d", rec.Code)
+	}
+	rec := sendSessionRequest(handler, http.MethodPost, `{"jsonrpc":"2.0","id":2,"method":"tools/list"}`, "alice", sessionID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404 after the session ended, got %d", rec.Code)
+	}
+}
+
+// TestHTTPSession_Disabled tests that calls without a session still work when sessions are off
+func TestHTTPSession_Disabled(t *testing.T) {
+	handler := createTestHandler(&auth.MockAuthorizer{})
+
+	rec := sendSessionRequest(handler, http.MethodPost, `{"jsonrpc":"2.0","id":2,"method":"tools/list"}`, "alice", "")
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", rec.Code)
+	}
+	if got := rec.Header().Get(SessionHeader); got != "" {
+		t.Errorf("Expected no session ID, got '%s'", got)
+	}
+}
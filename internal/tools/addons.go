@@ -0,0 +1,213 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RegisterAddonReadTools registers read-only add-on tools
+func RegisterAddonReadTools(s *server.MCPServer, c *client.Client) {
+	// list_addons
+	s.AddTool(mcp.NewTool("list_addons",
+		mcp.WithDescription("List add-ons that embed external pages in the PagerDuty UI, either as a full page or on the incident details page."),
+		mcp.WithTitleAnnotation("List Add-ons"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("type", mcp.Description("Only show add-ons of this type"), mcp.Enum("full_page_addon", "incident_show_addon")),
+		mcp.WithString("service_ids", mcp.Description("Only show add-ons for these services. Comma-separated service IDs (e.g., 'PSVC1,PSVC2')")),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+	), listAddonsHandler(c))
+
+	// get_addon
+	s.AddTool(mcp.NewTool("get_addon",
+		mcp.WithDescription("Get details of an add-on, including its type and embedded page URL."),
+		mcp.WithTitleAnnotation("Get Add-on"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("addon_id", mcp.Required(), mcp.Description("The unique add-on ID")),
+	), getAddonHandler(c))
+}
+
+// RegisterAddonWriteTools registers write add-on tools
+func RegisterAddonWriteTools(s *server.MCPServer, c *client.Client) {
+	// install_addon
+	s.AddTool(mcp.NewTool("install_addon",
+		mcp.WithDescription("Install an add-on that embeds an external page (such as a dashboard) in the PagerDuty UI."),
+		mcp.WithTitleAnnotation("Install Add-on"),
+		mcp.WithString("type", mcp.Required(), mcp.Description("Where the page is shown: as its own page or on incident details"), mcp.Enum("full_page_addon", "incident_show_addon")),
+		mcp.WithString("name", mcp.Required(), mcp.Description("The name shown in the PagerDuty UI (e.g., 'Service Dashboard')")),
+		mcp.WithString("src", mcp.Required(), mcp.Description("The https URL of the page to embed")),
+	), installAddonHandler(c))
+
+	// update_addon
+	s.AddTool(mcp.NewTool("update_addon",
+		mcp.WithDescription("Update an add-on's name or embedded page URL. Only the provided fields are changed."),
+		mcp.WithTitleAnnotation("Update Add-on"),
+		mcp.WithString("addon_id", mcp.Required(), mcp.Description("The unique add-on ID to update")),
+		mcp.WithString("name", mcp.Description("New add-on name")),
+		mcp.WithString("src", mcp.Description("New https URL of the page to embed")),
+	), updateAddonHandler(c))
+
+	// delete_addon
+	s.AddTool(mcp.NewTool("delete_addon",
+		mcp.WithDescription("WARNING: DESTRUCTIVE - Permanently remove an add-on. Its page will no longer be shown in the PagerDuty UI."),
+		mcp.WithTitleAnnotation("Delete Add-on"),
+		mcp.WithDestructiveHintAnnotation(true),
+		mcp.WithString("addon_id", mcp.Required(), mcp.Description("The unique add-on ID to delete")),
+	), deleteAddonHandler(c))
+}
+
+// isHTTPSURL reports whether value is an https URL; PagerDuty only embeds pages served over https
+func isHTTPSURL(value string) bool {
+	return isHTTPURL(value) && strings.HasPrefix(strings.ToLower(value), "https:")
+}
+
+func listAddonsHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		params := make(map[string][]string)
+
+		if v, ok := getString(args, "type"); ok {
+			params["filter"] = []string{v}
+		}
+		if v, ok := getStringArray(args, "service_ids"); ok {
+			params["service_ids[]"] = v
+		}
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = []string{fmt.Sprintf("%d", v)}
+		}
+
+		var resp models.AddonsResponse
+		if err := c.GetJSONWithArrayParamsContext(ctx, "/addons", params, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		result := models.ListResponse[models.Addon]{Response: resp.Addons}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
+	}
+}
+
+func getAddonHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		addonID, ok := getString(args, "addon_id")
+		if !ok {
+			return mcp.NewToolResultError("addon_id is required"), nil
+		}
+
+		var resp models.AddonResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/addons/%s", addonID), nil, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.Addon)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func installAddonHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		addonType, ok := getString(args, "type")
+		if !ok {
+			return mcp.NewToolResultError("type is required"), nil
+		}
+
+		name, ok := getString(args, "name")
+		if !ok {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+
+		src, ok := getString(args, "src")
+		if !ok {
+			return mcp.NewToolResultError("src is required"), nil
+		}
+		if !isHTTPSURL(src) {
+			return mcp.NewToolResultError("invalid src format: expected an https URL"), nil
+		}
+
+		req := models.AddonRequest{Addon: models.Addon{
+			Type: addonType,
+			Name: name,
+			Src:  src,
+		}}
+
+		var resp models.AddonResponse
+		if err := c.PostJSONWithContext(ctx, "/addons", req, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.Addon)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func updateAddonHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		addonID, ok := getString(args, "addon_id")
+		if !ok {
+			return mcp.NewToolResultError("addon_id is required"), nil
+		}
+
+		name, hasName := getString(args, "name")
+		src, hasSrc := getString(args, "src")
+		if !hasName && !hasSrc {
+			return mcp.NewToolResultError("at least one of name or src is required"), nil
+		}
+		if hasSrc && !isHTTPSURL(src) {
+			return mcp.NewToolResultError("invalid src format: expected an https URL"), nil
+		}
+
+		// The API replaces the whole add-on, so fill unchanged fields from the current one
+		var current models.AddonResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/addons/%s", addonID), nil, &current); err != nil {
+			return toolError(fmt.Errorf("failed to get add-on: %w", err)), nil
+		}
+		addon := models.Addon{
+			Type:     current.Addon.Type,
+			Name:     current.Addon.Name,
+			Src:      current.Addon.Src,
+			Services: current.Addon.Services,
+		}
+		if hasName {
+			addon.Name = name
+		}
+		if hasSrc {
+			addon.Src = src
+		}
+
+		req := models.AddonRequest{Addon: addon}
+
+		var resp models.AddonResponse
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/addons/%s", addonID), req, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.Addon)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func deleteAddonHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		addonID, ok := getString(args, "addon_id")
+		if !ok {
+			return mcp.NewToolResultError("addon_id is required"), nil
+		}
+
+		if _, err := c.DeleteWithContext(ctx, fmt.Sprintf("/addons/%s", addonID)); err != nil {
+			return toolError(err), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Add-on %s deleted successfully", addonID)), nil
+	}
+}
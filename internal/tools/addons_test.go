@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+)
+
+// TestUpdateAddon_KeepsUnchangedFields tests that fields not being updated are copied from the current add-on
+func TestUpdateAddon_KeepsUnchangedFields(t *testing.T) {
+	var req models.AddonRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &req); err != nil {
+				t.Errorf("Failed to parse request body: %v", err)
+			}
+			w.Write(body)
+			return
+		}
+		w.Write([]byte(`{"addon":{"id":"PADD1","type":"incident_show_addon","name":"Runbook","src":"https://old.example.com","services":[{"id":"PSVC1","type":"service_reference"}]}}`))
+	})
+
+	result, err := updateAddonHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"addon_id": "PADD1",
+		"src":      "https://new.example.com",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	want := models.Addon{Type: "incident_show_addon", Name: "Runbook", Src: "https://new.example.com"}
+	if req.Addon.Type != want.Type || req.Addon.Name != want.Name || req.Addon.Src != want.Src {
+		t.Errorf("Expected %+v, got %+v", want, req.Addon)
+	}
+	if len(req.Addon.Services) != 1 || req.Addon.Services[0].ID != "PSVC1" {
+		t.Errorf("Expected services [PSVC1] to be kept, got %+v", req.Addon.Services)
+	}
+}
+
+// TestInstallAddon_RequiresHTTPS tests that non-https page URLs are rejected before calling the API
+func TestInstallAddon_RequiresHTTPS(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no API call, got %s %s", r.Method, r.URL.Path)
+	})
+
+	result, _ := installAddonHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"type": "full_page_addon",
+		"name": "Dashboard",
+		"src":  "http://dashboard.example.com",
+	}))
+	if !result.IsError {
+		t.Error("Expected an error result for an http URL")
+	}
+}
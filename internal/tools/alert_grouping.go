@@ -41,14 +41,18 @@ func RegisterAlertGroupingWriteTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("service_ids", mcp.Required(), mcp.Description("Services to apply this grouping to. Comma-separated service IDs (e.g., 'PDSVC1,PDSVC2')")),
 		mcp.WithString("type", mcp.Required(), mcp.Description("Alert grouping strategy"), mcp.Enum("time", "intelligent", "content_based")),
 		mcp.WithNumber("timeout", mcp.Description("Time window in minutes for grouping alerts (only for 'time' type, default: 5)"), mcp.Min(1), mcp.Max(1440)),
+		mcp.WithString("fields", mcp.Description("Alert fields to group on. Comma-separated (e.g., 'summary,component'). Required for 'content_based'; optional for 'intelligent'.")),
+		mcp.WithString("aggregate", mcp.Description("Whether alerts must match on all fields or any field (only for 'content_based', default: all)"), mcp.Enum("all", "any")),
+		mcp.WithNumber("time_window", mcp.Description("How long in seconds a new alert may be grouped into an open incident (only for 'content_based' and 'intelligent')"), mcp.Min(300), mcp.Max(3600)),
 	), createAlertGroupingSettingHandler(c))
 
 	// update_alert_grouping_setting
 	s.AddTool(mcp.NewTool("update_alert_grouping_setting",
-		mcp.WithDescription("Update an existing alert grouping configuration. Can change the grouping strategy or timeout settings."),
+		mcp.WithDescription("Update an existing alert grouping configuration. Can change the grouping strategy, timeout settings, or which services it applies to."),
 		mcp.WithTitleAnnotation("Update Alert Grouping Setting"),
 		mcp.WithString("setting_id", mcp.Required(), mcp.Description("The unique alert grouping setting ID to update")),
 		mcp.WithString("name", mcp.Description("New name for the setting")),
+		mcp.WithString("service_ids", mcp.Description("The full new list of services, replacing the current list. Comma-separated service IDs (e.g., 'PDSVC1,PDSVC2'). At least one is required.")),
 		mcp.WithString("type", mcp.Description("New grouping strategy"), mcp.Enum("time", "intelligent", "content_based")),
 		mcp.WithNumber("timeout", mcp.Description("New time window in minutes (only for 'time' type)"), mcp.Min(1), mcp.Max(1440)),
 	), updateAlertGroupingSettingHandler(c))
@@ -65,23 +69,24 @@ func RegisterAlertGroupingWriteTools(s *server.MCPServer, c *client.Client) {
 func listAlertGroupingSettingsHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
-		params := make(map[string]string)
+		params := make(map[string][]string)
 
-		if v, ok := getString(args, "service_ids"); ok {
+		if v, ok := getStringArray(args, "service_ids"); ok {
 			params["service_ids[]"] = v
 		}
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = []string{fmt.Sprintf("%d", v)}
 		}
 
 		var resp models.AlertGroupingSettingsResponse
-		if err := c.GetJSON("/alert_grouping_settings", params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithArrayParamsContext(ctx, "/alert_grouping_settings", params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		result := models.ListResponse[models.AlertGroupingSetting]{Response: resp.AlertGroupingSettings}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		result := models.ListResponse[models.AlertGroupingSetting]{Response: resp.AlertGroupingSettings}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
 	}
 }
 
@@ -94,8 +99,8 @@ func getAlertGroupingSettingHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.AlertGroupingSettingResponse
-		if err := c.GetJSON(fmt.Sprintf("/alert_grouping_settings/%s", settingID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/alert_grouping_settings/%s", settingID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.AlertGroupingSetting)
@@ -121,21 +126,11 @@ func createAlertGroupingSettingHandler(c *client.Client) server.ToolHandlerFunc
 			return mcp.NewToolResultError("type is required"), nil
 		}
 
-		serviceIDs := splitAndTrim(serviceIDsStr)
-		services := make([]models.ServiceReference, len(serviceIDs))
-		for i, id := range serviceIDs {
-			services[i] = models.ServiceReference{
-				ID:   id,
-				Type: "service_reference",
-			}
-		}
-
-		config := models.AlertGroupingConfig{
-			Type: groupingType,
-		}
+		services := serviceReferences(splitAndTrim(serviceIDsStr))
 
-		if v, ok := getNumber(args, "timeout"); ok {
-			config.Timeout = int(v)
+		config, err := alertGroupingConfigFromArgs(groupingType, args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
 		setting := models.AlertGroupingSettingCreate{
@@ -148,8 +143,8 @@ func createAlertGroupingSettingHandler(c *client.Client) server.ToolHandlerFunc
 		req := models.AlertGroupingSettingCreateRequest{AlertGroupingSetting: setting}
 
 		var resp models.AlertGroupingSettingResponse
-		if err := c.PostJSON("/alert_grouping_settings", req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PostJSONWithContext(ctx, "/alert_grouping_settings", req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.AlertGroupingSetting)
@@ -172,21 +167,30 @@ func updateAlertGroupingSettingHandler(c *client.Client) server.ToolHandlerFunc
 		if v, ok := getString(args, "name"); ok {
 			setting.Name = v
 		}
+		if _, present := args["service_ids"]; present {
+			serviceIDs, ok := getStringArray(args, "service_ids")
+			if !ok {
+				return mcp.NewToolResultError("service_ids must include at least one service; use delete_alert_grouping_setting to remove the setting entirely"), nil
+			}
+			setting.Services = serviceReferences(serviceIDs)
+		}
 		if v, ok := getString(args, "type"); ok {
 			setting.Config = &models.AlertGroupingConfig{Type: v}
 		}
-		if v, ok := getNumber(args, "timeout"); ok {
+		if v, ok, err := getInteger(args, "timeout", 1, 1440); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
 			if setting.Config == nil {
 				setting.Config = &models.AlertGroupingConfig{}
 			}
-			setting.Config.Timeout = int(v)
+			setting.Config.Timeout = v
 		}
 
 		req := models.AlertGroupingSettingUpdateRequest{AlertGroupingSetting: setting}
 
 		var resp models.AlertGroupingSettingResponse
-		if err := c.PutJSON(fmt.Sprintf("/alert_grouping_settings/%s", settingID), req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/alert_grouping_settings/%s", settingID), req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.AlertGroupingSetting)
@@ -202,10 +206,72 @@ func deleteAlertGroupingSettingHandler(c *client.Client) server.ToolHandlerFunc
 			return mcp.NewToolResultError("setting_id is required"), nil
 		}
 
-		if _, err := c.Delete(fmt.Sprintf("/alert_grouping_settings/%s", settingID)); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if _, err := c.DeleteWithContext(ctx, fmt.Sprintf("/alert_grouping_settings/%s", settingID)); err != nil {
+			return toolError(err), nil
 		}
 
 		return mcp.NewToolResultText(fmt.Sprintf("Alert grouping setting %s deleted successfully", settingID)), nil
 	}
 }
+
+// alertGroupingConfigFromArgs builds the config for groupingType, rejecting
+// arguments that don't apply to it so they aren't silently dropped
+func alertGroupingConfigFromArgs(groupingType string, args map[string]any) (models.AlertGroupingConfig, error) {
+	config := models.AlertGroupingConfig{Type: groupingType}
+
+	timeout, hasTimeout, err := getInteger(args, "timeout", 1, 1440)
+	if err != nil {
+		return config, err
+	}
+	timeWindow, hasTimeWindow, err := getInteger(args, "time_window", 300, 3600)
+	if err != nil {
+		return config, err
+	}
+	fields, hasFields := getStringArray(args, "fields")
+	aggregate, hasAggregate := getString(args, "aggregate")
+	if hasAggregate && aggregate != "all" && aggregate != "any" {
+		return config, fmt.Errorf("aggregate must be 'all' or 'any'")
+	}
+
+	switch groupingType {
+	case "time":
+		if hasFields || hasAggregate || hasTimeWindow {
+			return config, fmt.Errorf("fields, aggregate, and time_window do not apply to 'time' grouping; use timeout")
+		}
+		config.Timeout = timeout
+	case "content_based":
+		if hasTimeout {
+			return config, fmt.Errorf("timeout only applies to 'time' grouping; use time_window")
+		}
+		if !hasFields {
+			return config, fmt.Errorf("fields is required for 'content_based' grouping")
+		}
+		config.Fields = fields
+		config.Aggregate = "all"
+		if hasAggregate {
+			config.Aggregate = aggregate
+		}
+		config.TimeWindow = timeWindow
+	case "intelligent":
+		if hasTimeout || hasAggregate {
+			return config, fmt.Errorf("timeout and aggregate do not apply to 'intelligent' grouping")
+		}
+		config.Fields = fields
+		config.TimeWindow = timeWindow
+	default:
+		return config, fmt.Errorf("type must be one of: time, intelligent, content_based")
+	}
+	return config, nil
+}
+
+// serviceReferences builds service references from service IDs
+func serviceReferences(ids []string) []models.ServiceReference {
+	services := make([]models.ServiceReference, len(ids))
+	for i, id := range ids {
+		services[i] = models.ServiceReference{
+			ID:   id,
+			Type: "service_reference",
+		}
+	}
+	return services
+}
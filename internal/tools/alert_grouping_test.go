@@ -0,0 +1,101 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+)
+
+// TestUpdateAlertGroupingSetting_Services tests that service_ids replaces the
+// setting's services and that an empty list is rejected
+func TestUpdateAlertGroupingSetting_Services(t *testing.T) {
+	var body models.AlertGroupingSettingUpdateRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/alert_grouping_settings/PAGS1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		w.Write([]byte(`{"alert_grouping_setting":{"id":"PAGS1"}}`))
+	})
+
+	result, err := updateAlertGroupingSettingHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"setting_id":  "PAGS1",
+		"service_ids": "PSVC1, PSVC2",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	services := body.AlertGroupingSetting.Services
+	if len(services) != 2 || services[0].ID != "PSVC1" || services[1].ID != "PSVC2" || services[0].Type != "service_reference" {
+		t.Errorf("Expected service references [PSVC1 PSVC2], got %+v", services)
+	}
+	if body.AlertGroupingSetting.Config != nil {
+		t.Errorf("Expected config to be left unchanged, got %+v", body.AlertGroupingSetting.Config)
+	}
+
+	result, err = updateAlertGroupingSettingHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"setting_id":  "PAGS1",
+		"service_ids": " , ",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an empty service_ids to be rejected")
+	}
+}
+
+// TestCreateAlertGroupingSetting_ContentBased tests that content-based options are
+// sent in the config and that fields is required for that type
+func TestCreateAlertGroupingSetting_ContentBased(t *testing.T) {
+	var body models.AlertGroupingSettingCreateRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		w.Write([]byte(`{"alert_grouping_setting":{"id":"PAGS1"}}`))
+	})
+
+	result, err := createAlertGroupingSettingHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"name":        "DB alerts",
+		"service_ids": "PSVC1",
+		"type":        "content_based",
+		"fields":      "summary,component",
+		"aggregate":   "any",
+		"time_window": float64(900),
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	config := body.AlertGroupingSetting.Config
+	if config.Type != "content_based" || config.Aggregate != "any" || config.TimeWindow != 900 || len(config.Fields) != 2 || config.Fields[1] != "component" {
+		t.Errorf("Unexpected config %+v", config)
+	}
+
+	for name, args := range map[string]map[string]any{
+		"missing fields":    {"type": "content_based"},
+		"fields for time":   {"type": "time", "fields": "summary"},
+		"timeout for smart": {"type": "intelligent", "timeout": float64(5)},
+	} {
+		args["name"] = "DB alerts"
+		args["service_ids"] = "PSVC1"
+		result, err := createAlertGroupingSettingHandler(c)(context.Background(), newToolRequest(args))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if !result.IsError {
+			t.Errorf("%s: expected an error result", name)
+		}
+	}
+}
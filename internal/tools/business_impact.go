@@ -0,0 +1,158 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxDependencyLookups bounds how many services the business impact walk
+// visits, so a large or cyclic service graph can't fan out unbounded
+const maxDependencyLookups = 50
+
+// dependencyNode is a service reached while walking the service graph
+type dependencyNode struct {
+	id       string
+	business bool
+	path     []string
+}
+
+func getIncidentBusinessImpactHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+
+		var resp models.IncidentResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s", incidentID), nil, &resp); err != nil {
+			return toolError(err), nil
+		}
+		incident := resp.Incident
+
+		impact := models.IncidentBusinessImpact{
+			IncidentID:       incident.ID,
+			IncidentStatus:   incident.Status,
+			Service:          incident.Service,
+			BusinessServices: []models.ImpactedBusinessService{},
+		}
+		if incident.Service == nil {
+			impact.Summary = fmt.Sprintf("Incident %s has no service, so no business services are impacted", incident.ID)
+			data, _ := json.Marshal(impact)
+			return mcp.NewToolResultText(string(data)), nil
+		}
+
+		reached, truncated, err := walkDependentBusinessServices(ctx, c, incident.Service.ID)
+		if err != nil {
+			return toolError(err), nil
+		}
+		impact.Truncated = truncated
+
+		// Derived from this incident only; other incidents may still affect the business service
+		status := "impacted"
+		if incident.Status == "resolved" {
+			status = "recovered"
+		}
+
+		// Business service references carry no name, so look each one up
+		details := make([]models.BusinessService, len(reached))
+		errs := forEachConcurrent(ctx, len(reached), maxConcurrentRequests, func(ctx context.Context, i int) error {
+			var bs models.BusinessServiceResponse
+			if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/business_services/%s", reached[i].id), nil, &bs); err != nil {
+				return err
+			}
+			details[i] = bs.BusinessService
+			return nil
+		})
+		for i, node := range reached {
+			// A name lookup failure shouldn't hide the impact itself
+			entry := models.ImpactedBusinessService{ID: node.id, IncidentStatusDerived: status, Path: node.path}
+			if errs[i] == nil {
+				entry.Name = details[i].Name
+				entry.PointOfContact = details[i].PointOfContact
+			}
+			impact.BusinessServices = append(impact.BusinessServices, entry)
+		}
+
+		impact.Summary = businessImpactSummary(impact)
+		data, _ := json.Marshal(impact)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+// walkDependentBusinessServices walks the service graph upward from a technical
+// service, following dependent services, and returns every business service
+// reached in breadth-first order. truncated reports that the walk stopped at
+// maxDependencyLookups before visiting everything.
+func walkDependentBusinessServices(ctx context.Context, c *client.Client, serviceID string) ([]dependencyNode, bool, error) {
+	var reached []dependencyNode
+	visited := map[string]bool{serviceID: true}
+	queue := []dependencyNode{{id: serviceID, path: []string{serviceID}}}
+	lookups := 0
+
+	for len(queue) > 0 {
+		if lookups == maxDependencyLookups {
+			return reached, true, nil
+		}
+		node := queue[0]
+		queue = queue[1:]
+		lookups++
+
+		kind := "technical_services"
+		if node.business {
+			kind = "business_services"
+		}
+		var resp models.ServiceDependenciesResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/service_dependencies/%s/%s", kind, node.id), nil, &resp); err != nil {
+			return nil, false, fmt.Errorf("failed to get dependencies of service %s: %w", node.id, err)
+		}
+
+		// Relationships list both directions; only follow services that depend on this one
+		for _, rel := range resp.Relationships {
+			if rel.SupportingService.ID != node.id || visited[rel.DependentService.ID] {
+				continue
+			}
+			visited[rel.DependentService.ID] = true
+			next := dependencyNode{
+				id:       rel.DependentService.ID,
+				business: strings.HasPrefix(rel.DependentService.Type, "business_service"),
+				path:     append(append([]string{}, node.path...), rel.DependentService.ID),
+			}
+			if next.business {
+				reached = append(reached, next)
+			}
+			queue = append(queue, next)
+		}
+	}
+	return reached, false, nil
+}
+
+// businessImpactSummary describes the impact in one sentence
+func businessImpactSummary(impact models.IncidentBusinessImpact) string {
+	if len(impact.BusinessServices) == 0 {
+		return fmt.Sprintf("No business services depend on service %s", impact.Service.ID)
+	}
+	names := make([]string, len(impact.BusinessServices))
+	for i, bs := range impact.BusinessServices {
+		names[i] = bs.Name
+		if names[i] == "" {
+			names[i] = bs.ID
+		}
+	}
+	verb := "impacts"
+	if impact.IncidentStatus == "resolved" {
+		verb = "impacted"
+	}
+	summary := fmt.Sprintf("Incident %s %s %d business service(s): %s", impact.IncidentID, verb, len(names), strings.Join(names, ", "))
+	if impact.Truncated {
+		summary += fmt.Sprintf(" (service graph walk stopped after %d services; more may be affected)", maxDependencyLookups)
+	}
+	return summary
+}
@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestGetIncidentBusinessImpact tests that business services are found through
+// direct and transitive dependents, and that supporting services are ignored
+func TestGetIncidentBusinessImpact(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents/PINC1":
+			w.Write([]byte(`{"incident":{"id":"PINC1","status":"triggered","service":{"id":"PSVC1"}}}`))
+		case "/service_dependencies/technical_services/PSVC1":
+			w.Write([]byte(`{"relationships":[
+				{"supporting_service":{"id":"PSVC1","type":"technical_service_reference"},"dependent_service":{"id":"PBS1","type":"business_service_reference"}},
+				{"supporting_service":{"id":"PSVC1","type":"technical_service_reference"},"dependent_service":{"id":"PSVC2","type":"technical_service_reference"}},
+				{"supporting_service":{"id":"PDB","type":"technical_service_reference"},"dependent_service":{"id":"PSVC1","type":"technical_service_reference"}}
+			]}`))
+		case "/service_dependencies/technical_services/PSVC2":
+			w.Write([]byte(`{"relationships":[
+				{"supporting_service":{"id":"PSVC2","type":"technical_service_reference"},"dependent_service":{"id":"PBS2","type":"business_service_reference"}}
+			]}`))
+		case "/service_dependencies/business_services/PBS1", "/service_dependencies/business_services/PBS2":
+			w.Write([]byte(`{"relationships":[]}`))
+		case "/business_services/PBS1":
+			w.Write([]byte(`{"business_service":{"id":"PBS1","name":"Checkout"}}`))
+		case "/business_services/PBS2":
+			w.Write([]byte(`{"business_service":{"id":"PBS2","name":"Search"}}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	result, err := getIncidentBusinessImpactHandler(c)(context.Background(), newToolRequest(map[string]any{"incident_id": "PINC1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out models.IncidentBusinessImpact
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.BusinessServices) != 2 {
+		t.Fatalf("Expected 2 business services, got %+v", out.BusinessServices)
+	}
+	checkout, search := out.BusinessServices[0], out.BusinessServices[1]
+	if checkout.Name != "Checkout" || checkout.IncidentStatusDerived != "impacted" || len(checkout.Path) != 2 {
+		t.Errorf("Unexpected direct business service %+v", checkout)
+	}
+	if search.Name != "Search" || len(search.Path) != 3 || search.Path[1] != "PSVC2" {
+		t.Errorf("Unexpected transitive business service %+v", search)
+	}
+	if out.Summary != "Incident PINC1 impacts 2 business service(s): Checkout, Search" {
+		t.Errorf("Unexpected summary '%s'", out.Summary)
+	}
+}
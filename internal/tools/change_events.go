@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
+	"time"
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
@@ -23,6 +26,8 @@ func RegisterChangeEventReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("team_ids", mcp.Description("Filter by teams. Comma-separated team IDs (e.g., 'PTEAM1,PTEAM2')")),
 		mcp.WithString("service_ids", mcp.Description("Filter by services. Comma-separated service IDs (e.g., 'PDSVC1,PDSVC2')")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+		mcp.WithNumber("offset", mcp.Description("Number of records to skip. Pass next_offset from the previous response to fetch the next page."), mcp.Min(0)),
+		mcp.WithBoolean("group_by_service", mcp.Description("Group the results by service into compact timelines, oldest change first, with the most recently changed service listed first. Useful for triaging a broad outage across many services. Paging still counts change events, not timelines.")),
 	), listChangeEventsHandler(c))
 
 	// get_change_event
@@ -42,47 +47,67 @@ func RegisterChangeEventReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("since", mcp.Description("Start date in ISO 8601 format (e.g., '2024-01-15T00:00:00Z')")),
 		mcp.WithString("until", mcp.Description("End date in ISO 8601 format (e.g., '2024-01-16T00:00:00Z')")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+		mcp.WithNumber("offset", mcp.Description("Number of records to skip. Pass next_offset from the previous response to fetch the next page."), mcp.Min(0)),
 	), listServiceChangeEventsHandler(c))
 
 	// list_incident_change_events
 	s.AddTool(mcp.NewTool("list_incident_change_events",
-		mcp.WithDescription("List change events that PagerDuty has automatically correlated with an incident. Shows deployments and changes that occurred around the time the incident started and may have caused it."),
+		mcp.WithDescription("List change events that PagerDuty has automatically correlated with an incident, newest first. Shows deployments and changes that occurred around the time the incident started and may have caused it. Narrow to a window around the incident start with since/until."),
 		mcp.WithTitleAnnotation("List Related Change Events"),
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+		mcp.WithString("since", mcp.Description("Only include changes at or after this time, in ISO 8601 format (e.g., '2024-01-15T09:00:00Z')")),
+		mcp.WithString("until", mcp.Description("Only include changes before this time, in ISO 8601 format (e.g., '2024-01-15T11:00:00Z')")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+		mcp.WithNumber("offset", mcp.Description("Number of records to skip. Pass next_offset from the previous response to fetch the next page."), mcp.Min(0)),
 	), listIncidentChangeEventsHandler(c))
 }
 
 func listChangeEventsHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
-		params := make(map[string]string)
+		params := make(map[string][]string)
 
-		if v, ok := getString(args, "since"); ok {
-			params["since"] = v
+		if v, ok, err := getDateTime(args, "since"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["since"] = []string{v}
 		}
-		if v, ok := getString(args, "until"); ok {
-			params["until"] = v
+		if v, ok, err := getDateTime(args, "until"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["until"] = []string{v}
 		}
-		if v, ok := getString(args, "team_ids"); ok {
+		if v, ok := getStringArray(args, "team_ids"); ok {
 			params["team_ids[]"] = v
 		}
-		if v, ok := getString(args, "service_ids"); ok {
+		if v, ok := getStringArray(args, "service_ids"); ok {
 			params["service_ids[]"] = v
 		}
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = []string{fmt.Sprintf("%d", v)}
+		}
+		if v, ok, err := getInteger(args, "offset", 0, math.MaxInt); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["offset"] = []string{fmt.Sprintf("%d", v)}
 		}
 
 		var resp models.ChangeEventsResponse
-		if err := c.GetJSON("/change_events", params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithArrayParamsContext(ctx, "/change_events", params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		result := models.ListResponse[models.ChangeEvent]{Response: resp.ChangeEvents}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		// Timelines summarize the page's change events, so paging follows the events
+		if groupByService, _ := getBool(args, "group_by_service"); groupByService {
+			timelines := groupChangeEventsByService(resp.ChangeEvents)
+			return listResult(models.ListResponse[models.ServiceChangeTimeline]{Response: timelines}.WithSourcePage(resp.Offset, len(resp.ChangeEvents), resp.More)), nil
+		}
+
+		result := models.ListResponse[models.ChangeEvent]{Response: resp.ChangeEvents}.WithPage(resp.Offset, resp.More, resp.Total)
+		return listResult(result), nil
 	}
 }
 
@@ -95,8 +120,8 @@ func getChangeEventHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.ChangeEventResponse
-		if err := c.GetJSON(fmt.Sprintf("/change_events/%s", changeEventID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/change_events/%s", changeEventID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.ChangeEvent)
@@ -113,24 +138,34 @@ func listServiceChangeEventsHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		params := make(map[string]string)
-		if v, ok := getString(args, "since"); ok {
+		if v, ok, err := getDateTime(args, "since"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
 			params["since"] = v
 		}
-		if v, ok := getString(args, "until"); ok {
+		if v, ok, err := getDateTime(args, "until"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
 			params["until"] = v
 		}
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
+		}
+		if v, ok, err := getInteger(args, "offset", 0, math.MaxInt); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["offset"] = fmt.Sprintf("%d", v)
 		}
 
 		var resp models.ChangeEventsResponse
-		if err := c.GetJSON(fmt.Sprintf("/services/%s/change_events", serviceID), params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/services/%s/change_events", serviceID), params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		result := models.ListResponse[models.ChangeEvent]{Response: resp.ChangeEvents}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		result := models.ListResponse[models.ChangeEvent]{Response: resp.ChangeEvents}.WithPage(resp.Offset, resp.More, resp.Total)
+		return listResult(result), nil
 	}
 }
 
@@ -143,17 +178,114 @@ func listIncidentChangeEventsHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		params := make(map[string]string)
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		var since, until time.Time
+		if v, ok, err := getDateTime(args, "since"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["since"] = v
+			since, _ = time.Parse(time.RFC3339, v)
+		}
+		if v, ok, err := getDateTime(args, "until"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["until"] = v
+			until, _ = time.Parse(time.RFC3339, v)
+		}
+		if !since.IsZero() && !until.IsZero() && !since.Before(until) {
+			return mcp.NewToolResultError("since must be before until"), nil
+		}
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
+		}
+		if v, ok, err := getInteger(args, "offset", 0, math.MaxInt); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["offset"] = fmt.Sprintf("%d", v)
 		}
 
 		var resp models.ChangeEventsResponse
-		if err := c.GetJSON(fmt.Sprintf("/incidents/%s/related_change_events", incidentID), params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/related_change_events", incidentID), params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		result := models.ListResponse[models.ChangeEvent]{Response: resp.ChangeEvents}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		// The window is also applied here since the related changes endpoint
+		// may not filter by it
+		events := filterChangeEventsByTime(resp.ChangeEvents, since, until)
+		sort.SliceStable(events, func(i, j int) bool {
+			return events[i].Timestamp.After(events[j].Timestamp.Time)
+		})
+
+		// Paging follows the page PagerDuty returned; its total no longer
+		// describes the list once the window removed entries
+		result := models.ListResponse[models.ChangeEvent]{Response: events}
+		if len(events) < len(resp.ChangeEvents) {
+			result = result.WithSourcePage(resp.Offset, len(resp.ChangeEvents), resp.More)
+		} else {
+			result = result.WithPage(resp.Offset, resp.More, resp.Total)
+		}
+		return listResult(result), nil
+	}
+}
+
+// filterChangeEventsByTime keeps the events in [since, until). A zero bound is
+// unbounded, and events without a timestamp are kept.
+func filterChangeEventsByTime(events []models.ChangeEvent, since, until time.Time) []models.ChangeEvent {
+	if since.IsZero() && until.IsZero() {
+		return events
+	}
+	var filtered []models.ChangeEvent
+	for _, event := range events {
+		ts := event.Timestamp.Time
+		if !ts.IsZero() && ((!since.IsZero() && ts.Before(since)) || (!until.IsZero() && !ts.Before(until))) {
+			continue
+		}
+		filtered = append(filtered, event)
+	}
+	return filtered
+}
+
+// groupChangeEventsByService builds one timeline per service, with events in
+// chronological order and the most recently changed service first. An event
+// that touches several services appears in each of their timelines; events
+// without a service are grouped under an empty service reference.
+func groupChangeEventsByService(events []models.ChangeEvent) []models.ServiceChangeTimeline {
+	index := make(map[string]int)
+	var timelines []models.ServiceChangeTimeline
+	for _, event := range events {
+		services := event.Services
+		if len(services) == 0 {
+			services = []models.ServiceReference{{}}
+		}
+		entry := models.ChangeTimelineEntry{
+			ID:        event.ID,
+			Timestamp: event.Timestamp,
+			Summary:   event.Summary,
+			Source:    event.Source,
+		}
+		for _, svc := range services {
+			i, ok := index[svc.ID]
+			if !ok {
+				i = len(timelines)
+				index[svc.ID] = i
+				timelines = append(timelines, models.ServiceChangeTimeline{Service: svc})
+			}
+			timelines[i].ChangeEvents = append(timelines[i].ChangeEvents, entry)
+		}
+	}
+
+	for i := range timelines {
+		t := &timelines[i]
+		sort.SliceStable(t.ChangeEvents, func(a, b int) bool {
+			return t.ChangeEvents[a].Timestamp.Before(t.ChangeEvents[b].Timestamp.Time)
+		})
+		t.Count = len(t.ChangeEvents)
+		t.FirstChange = t.ChangeEvents[0].Timestamp
+		t.LastChange = t.ChangeEvents[t.Count-1].Timestamp
 	}
+	sort.SliceStable(timelines, func(a, b int) bool {
+		return timelines[a].LastChange.After(timelines[b].LastChange.Time)
+	})
+	return timelines
 }
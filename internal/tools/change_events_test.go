@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestListIncidentChangeEvents_Window tests that since/until are forwarded,
+// applied to the results, that events are returned newest first, and that
+// paging follows the unfiltered page
+func TestListIncidentChangeEvents_Window(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incidents/PINC1/related_change_events" {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+		query = r.URL.Query()
+		w.Write([]byte(`{"change_events":[
+			{"id":"PCE1","summary":"early deploy","timestamp":"2024-01-15T08:00:00Z"},
+			{"id":"PCE2","summary":"deploy","timestamp":"2024-01-15T09:30:00Z"},
+			{"id":"PCE3","summary":"config change","timestamp":"2024-01-15T10:15:00Z"}
+		],"offset":20,"more":true,"total":50}`))
+	})
+
+	result, err := listIncidentChangeEventsHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"incident_id": "PINC1",
+		"since":       "2024-01-15T09:00:00Z",
+		"until":       "2024-01-15T11:00:00Z",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if query.Get("since") != "2024-01-15T09:00:00Z" || query.Get("until") != "2024-01-15T11:00:00Z" {
+		t.Errorf("Expected since/until to be forwarded, got %v", query)
+	}
+
+	var out models.ListResponse[models.ChangeEvent]
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Response) != 2 || out.Response[0].ID != "PCE3" || out.Response[1].ID != "PCE2" {
+		t.Errorf("Expected [PCE3 PCE2], got %+v", out.Response)
+	}
+	if !out.More || out.NextOffset != 23 || out.Total != 0 {
+		t.Errorf("Expected next_offset 23 past the unfiltered page and no total, got more=%v next_offset=%d total=%d", out.More, out.NextOffset, out.Total)
+	}
+
+	result, err = listIncidentChangeEventsHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"incident_id": "PINC1",
+		"since":       "2024-01-15T11:00:00Z",
+		"until":       "2024-01-15T09:00:00Z",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result when since is after until")
+	}
+}
+
+// TestListChangeEvents_GroupByService tests that change events are grouped
+// into per-service timelines in time order, most recently changed first, and
+// that paging follows the change events rather than the timelines
+func TestListChangeEvents_GroupByService(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"change_events":[
+			{"id":"PCE3","summary":"api deploy","timestamp":"2024-01-15T10:15:00Z","services":[{"id":"PSVC1","summary":"API"}]},
+			{"id":"PCE2","summary":"shared config","timestamp":"2024-01-15T09:30:00Z","services":[{"id":"PSVC1","summary":"API"},{"id":"PSVC2","summary":"Web"}]},
+			{"id":"PCE1","summary":"web deploy","timestamp":"2024-01-15T08:00:00Z","services":[{"id":"PSVC2","summary":"Web"}]}
+		],"offset":10,"more":true,"total":40}`))
+	})
+
+	result, err := listChangeEventsHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"group_by_service": true,
+		"offset":           float64(10),
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out models.ListResponse[models.ServiceChangeTimeline]
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Response) != 2 {
+		t.Fatalf("Expected 2 timelines, got %+v", out.Response)
+	}
+	api, web := out.Response[0], out.Response[1]
+	if api.Service.ID != "PSVC1" || web.Service.ID != "PSVC2" {
+		t.Fatalf("Expected [PSVC1 PSVC2], got [%s %s]", api.Service.ID, web.Service.ID)
+	}
+	if api.Count != 2 || api.ChangeEvents[0].ID != "PCE2" || api.ChangeEvents[1].ID != "PCE3" {
+		t.Errorf("Expected PSVC1 timeline [PCE2 PCE3], got %+v", api.ChangeEvents)
+	}
+	if web.Count != 2 || web.ChangeEvents[0].ID != "PCE1" || web.ChangeEvents[1].ID != "PCE2" {
+		t.Errorf("Expected PSVC2 timeline [PCE1 PCE2], got %+v", web.ChangeEvents)
+	}
+	if api.LastChange.Format("15:04") != "10:15" || web.FirstChange.Format("15:04") != "08:00" {
+		t.Errorf("Unexpected timeline bounds: %+v %+v", api, web)
+	}
+	if query.Get("offset") != "10" {
+		t.Errorf("Expected offset=10 to be forwarded, got %v", query)
+	}
+	if !out.More || out.NextOffset != 13 || out.Total != 0 {
+		t.Errorf("Expected more with next_offset 13 after 3 events and no event total, got more=%v next_offset=%d total=%d", out.More, out.NextOffset, out.Total)
+	}
+}
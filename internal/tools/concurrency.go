@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"context"
+	"sync"
+)
+
+// maxConcurrentRequests bounds how many PagerDuty calls a fan-out tool makes at
+// once, keeping latency low without tripping the API rate limits
+const maxConcurrentRequests = 5
+
+// forEachConcurrent calls fn for each index in [0, n) with at most limit calls in
+// flight and returns their errors in index order. Calls that have not started
+// when ctx is cancelled are skipped and report ctx.Err().
+func forEachConcurrent(ctx context.Context, n, limit int, fn func(ctx context.Context, i int) error) []error {
+	errs := make([]error, n)
+	if limit < 1 {
+		limit = 1
+	}
+
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = fn(ctx, i)
+		}()
+	}
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestForEachConcurrent_BoundedOverlap tests that requests overlap but never exceed the in-flight limit
+func TestForEachConcurrent_BoundedOverlap(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.Write([]byte(`{}`))
+	})
+
+	errs := forEachConcurrent(context.Background(), 10, 3, func(ctx context.Context, i int) error {
+		var resp map[string]any
+		return c.GetJSONWithContext(ctx, fmt.Sprintf("/services/P%d", i), nil, &resp)
+	})
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("Unexpected error for call %d: %v", i, err)
+		}
+	}
+	if maxInFlight < 2 {
+		t.Errorf("Expected calls to overlap, got max in flight %d", maxInFlight)
+	}
+	if maxInFlight > 3 {
+		t.Errorf("Expected at most 3 calls in flight, got %d", maxInFlight)
+	}
+}
+
+// TestForEachConcurrent_Errors tests that errors are reported in index order and cancellation skips unstarted calls
+func TestForEachConcurrent_Errors(t *testing.T) {
+	errs := forEachConcurrent(context.Background(), 3, 2, func(ctx context.Context, i int) error {
+		if i == 1 {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	if errs[0] != nil || errs[1] == nil || errs[2] != nil {
+		t.Errorf("Expected only call 1 to fail, got %v", errs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	errs = forEachConcurrent(ctx, 2, 1, func(ctx context.Context, i int) error {
+		t.Errorf("Expected call %d to be skipped", i)
+		return nil
+	})
+	for i, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected call %d to be skipped with context.Canceled, got %v", i, err)
+		}
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
+	"strings"
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
@@ -32,37 +35,88 @@ func RegisterEscalationPolicyReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithString("escalation_policy_id", mcp.Required(), mcp.Description("The unique escalation policy ID (e.g., 'PESCPOL123')")),
 	), getEscalationPolicyHandler(c))
+
+	// simulate_escalation_path
+	s.AddTool(mcp.NewTool("simulate_escalation_path",
+		mcp.WithDescription("Show who would be paged at each level of an escalation policy and when. Schedule targets are expanded to their current on-call users. Returns an ordered timeline of levels."),
+		mcp.WithTitleAnnotation("Simulate Escalation Path"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("escalation_policy_id", mcp.Required(), mcp.Description("The unique escalation policy ID (e.g., 'PESCPOL123')")),
+	), simulateEscalationPathHandler(c))
+
+	// get_escalation_policy_oncall
+	s.AddTool(mcp.NewTool("get_escalation_policy_oncall",
+		mcp.WithDescription("Get who is on call right now at each level of an escalation policy, ordered by escalation level. Returns an empty list with a clear summary when no one is on call."),
+		mcp.WithTitleAnnotation("Get Escalation Policy On-Call"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("escalation_policy_id", mcp.Required(), mcp.Description("The unique escalation policy ID (e.g., 'PESCPOL123')")),
+	), getEscalationPolicyOncallHandler(c))
+
+	// find_escalation_policies_for_target
+	s.AddTool(mcp.NewTool("find_escalation_policies_for_target",
+		mcp.WithDescription("Find the escalation policies that notify a user or schedule directly, and the levels (1 is the first) where it appears. Use before deleting a schedule or offboarding a user so no policy is left without coverage."),
+		mcp.WithTitleAnnotation("Find Escalation Policies For Target"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("target_type", mcp.Required(), mcp.Description("Whether target_id is a user or a schedule"), mcp.Enum("user", "schedule")),
+		mcp.WithString("target_id", mcp.Required(), mcp.Description("The ID of the user or schedule (e.g., 'PUSER123' or 'PSCHED123')")),
+	), findEscalationPoliciesForTargetHandler(c))
+}
+
+// RegisterEscalationPolicyWriteTools registers write escalation policy tools
+func RegisterEscalationPolicyWriteTools(s *server.MCPServer, c *client.Client) {
+	// add_escalation_target
+	s.AddTool(mcp.NewTool("add_escalation_target",
+		mcp.WithDescription("Add a user or schedule as a target on one escalation rule of a policy, leaving the rest of the policy unchanged. Omit rule_index to append a new escalation rule containing only this target. Use get_escalation_policy first to see the current rules."),
+		mcp.WithTitleAnnotation("Add Escalation Target"),
+		mcp.WithString("escalation_policy_id", mcp.Required(), mcp.Description("The unique escalation policy ID (e.g., 'PESCPOL123')")),
+		mcp.WithString("target_id", mcp.Required(), mcp.Description("The ID of the user or schedule to notify")),
+		mcp.WithString("target_type", mcp.Required(), mcp.Description("Whether target_id is a user or a schedule"), mcp.Enum("user", "schedule")),
+		mcp.WithNumber("rule_index", mcp.Description("Zero-based index of the escalation rule to add the target to (0 is the first level). Omit to append a new rule."), mcp.Min(0)),
+		mcp.WithNumber("escalation_delay_in_minutes", mcp.Description("Minutes before escalating past a newly appended rule (default: 30). Ignored when rule_index is set."), mcp.Min(1)),
+	), addEscalationTargetHandler(c))
+
+	// remove_escalation_target
+	s.AddTool(mcp.NewTool("remove_escalation_target",
+		mcp.WithDescription("WARNING: DESTRUCTIVE - Remove a user or schedule from one escalation rule of a policy, leaving the rest of the policy unchanged. The target will no longer be notified at that level. A rule must keep at least one target."),
+		mcp.WithTitleAnnotation("Remove Escalation Target"),
+		mcp.WithDestructiveHintAnnotation(true),
+		mcp.WithString("escalation_policy_id", mcp.Required(), mcp.Description("The unique escalation policy ID (e.g., 'PESCPOL123')")),
+		mcp.WithNumber("rule_index", mcp.Required(), mcp.Description("Zero-based index of the escalation rule to remove the target from (0 is the first level)"), mcp.Min(0)),
+		mcp.WithString("target_id", mcp.Required(), mcp.Description("The ID of the user or schedule to remove")),
+		mcp.WithString("target_type", mcp.Required(), mcp.Description("Whether target_id is a user or a schedule"), mcp.Enum("user", "schedule")),
+	), removeEscalationTargetHandler(c))
 }
 
 func listEscalationPoliciesHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
-		params := make(map[string]string)
+		params := make(map[string][]string)
 
 		if v, ok := getString(args, "query"); ok {
-			params["query"] = v
+			params["query"] = []string{v}
 		}
-		if v, ok := getString(args, "user_ids"); ok {
+		if v, ok := getStringArray(args, "user_ids"); ok {
 			params["user_ids[]"] = v
 		}
-		if v, ok := getString(args, "team_ids"); ok {
+		if v, ok := getStringArray(args, "team_ids"); ok {
 			params["team_ids[]"] = v
 		}
 		if v, ok := getString(args, "sort_by"); ok {
-			params["sort_by"] = v
+			params["sort_by"] = []string{v}
 		}
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = []string{fmt.Sprintf("%d", v)}
 		}
 
 		var resp models.EscalationPoliciesResponse
-		if err := c.GetJSON("/escalation_policies", params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithArrayParamsContext(ctx, "/escalation_policies", params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		result := models.ListResponse[models.EscalationPolicy]{Response: resp.EscalationPolicies}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		result := models.ListResponse[models.EscalationPolicy]{Response: resp.EscalationPolicies}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
 	}
 }
 
@@ -75,11 +129,375 @@ func getEscalationPolicyHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.EscalationPolicyResponse
-		if err := c.GetJSON(fmt.Sprintf("/escalation_policies/%s", policyID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/escalation_policies/%s", policyID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.EscalationPolicy)
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
+
+func simulateEscalationPathHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		policyID, ok := getString(args, "escalation_policy_id")
+		if !ok {
+			return mcp.NewToolResultError("escalation_policy_id is required"), nil
+		}
+
+		var policyResp models.EscalationPolicyResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/escalation_policies/%s", policyID), nil, &policyResp); err != nil {
+			return toolError(err), nil
+		}
+
+		// Current on-call users for every schedule in the policy. PagerDuty
+		// returns an entry per escalation level, so a schedule used at several
+		// levels is keyed by level as well.
+		type levelSchedule struct {
+			level      int
+			scheduleID string
+		}
+		scheduleOncalls := make(map[levelSchedule][]models.EscalationPathUser)
+		params := map[string]string{
+			"escalation_policy_ids[]": policyID,
+			"earliest":                "true",
+		}
+		more := false
+		err := c.PaginateWithContext(ctx, "/oncalls", params, models.MaxResults, func(data []byte) (int, error) {
+			var page models.OncallsResponse
+			if err := json.Unmarshal(data, &page); err != nil {
+				return 0, err
+			}
+			more = page.More
+			for _, oc := range page.Oncalls {
+				if oc.Schedule == nil {
+					continue
+				}
+				key := levelSchedule{oc.EscalationLevel, oc.Schedule.ID}
+				scheduleOncalls[key] = append(scheduleOncalls[key], models.EscalationPathUser{
+					UserID:       oc.User.ID,
+					UserName:     oc.User.Summary,
+					ScheduleID:   oc.Schedule.ID,
+					ScheduleName: oc.Schedule.Summary,
+				})
+			}
+			return len(page.Oncalls), nil
+		})
+		if err != nil {
+			return toolError(fmt.Errorf("failed to get on-calls: %w", err)), nil
+		}
+
+		rules := policyResp.EscalationPolicy.EscalationRules
+		steps := make([]models.EscalationPathStep, len(rules))
+		startsAfter := 0
+		for i, rule := range rules {
+			step := models.EscalationPathStep{
+				Level:              i + 1,
+				DelayMinutes:       rule.EscalationDelayInMinutes,
+				StartsAfterMinutes: startsAfter,
+				Users:              []models.EscalationPathUser{},
+			}
+
+			for _, target := range rule.Targets {
+				switch {
+				case strings.HasPrefix(target.Type, "schedule"):
+					step.Users = append(step.Users, scheduleOncalls[levelSchedule{step.Level, target.ID}]...)
+				case strings.HasPrefix(target.Type, "user"):
+					step.Users = append(step.Users, models.EscalationPathUser{
+						UserID:   target.ID,
+						UserName: target.Summary,
+					})
+				}
+			}
+
+			steps[i] = step
+			startsAfter += rule.EscalationDelayInMinutes
+		}
+
+		// Paging stops at MaxResults on-call entries with more still to read
+		resp := escalationPathResponse{
+			ListResponse:     models.ListResponse[models.EscalationPathStep]{Response: steps},
+			OncallsTruncated: more,
+		}
+		resp.Summary = resp.ListResponse.Summary()
+		if resp.OncallsTruncated {
+			resp.Summary += fmt.Sprintf(" Only the first %d on-call entries were read, so some schedule levels may be missing on-call users.", models.MaxResults)
+		}
+
+		data, _ := json.Marshal(resp)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+// escalationPathResponse is the simulate_escalation_path output: the steps
+// plus whether the on-calls behind them were read in full
+type escalationPathResponse struct {
+	models.ListResponse[models.EscalationPathStep]
+	OncallsTruncated bool   `json:"oncalls_truncated,omitempty"`
+	Summary          string `json:"summary"`
+}
+
+func getEscalationPolicyOncallHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		policyID, ok := getString(args, "escalation_policy_id")
+		if !ok {
+			return mcp.NewToolResultError("escalation_policy_id is required"), nil
+		}
+
+		params := map[string]string{
+			"escalation_policy_ids[]": policyID,
+			"earliest":                "true",
+		}
+		var oncalls []models.Oncall
+		more := false
+		err := c.PaginateWithContext(ctx, "/oncalls", params, models.MaxResults, func(data []byte) (int, error) {
+			var page models.OncallsResponse
+			if err := json.Unmarshal(data, &page); err != nil {
+				return 0, err
+			}
+			more = page.More
+			oncalls = append(oncalls, page.Oncalls...)
+			return len(page.Oncalls), nil
+		})
+		if err != nil {
+			return toolError(err), nil
+		}
+
+		sort.SliceStable(oncalls, func(i, j int) bool {
+			return oncalls[i].EscalationLevel < oncalls[j].EscalationLevel
+		})
+
+		// Paging stops at MaxResults on-call entries with more still to read
+		result := models.EscalationPolicyOncall{
+			EscalationPolicy: models.EscalationPolicyReference{ID: policyID},
+			Oncalls:          make([]models.OncallSummary, len(oncalls)),
+			OncallsTruncated: more,
+		}
+		levels := make(map[int]bool)
+		for i, oc := range oncalls {
+			if oc.EscalationPolicy.ID == policyID {
+				result.EscalationPolicy = oc.EscalationPolicy
+			}
+			result.Oncalls[i] = oncallSummary(oc)
+			levels[oc.EscalationLevel] = true
+		}
+
+		name := referenceLabel(result.EscalationPolicy.Summary, policyID)
+		if len(result.Oncalls) == 0 {
+			result.Summary = fmt.Sprintf("No one is currently on call for escalation policy %s", name)
+		} else {
+			result.Summary = fmt.Sprintf("%d on-call entries across %d escalation levels of %s", len(result.Oncalls), len(levels), name)
+		}
+		if result.OncallsTruncated {
+			result.Summary += fmt.Sprintf("; only the first %d on-call entries were read, so some levels may be missing", models.MaxResults)
+		}
+
+		data, _ := json.Marshal(result)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func findEscalationPoliciesForTargetHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		target, err := getEscalationTarget(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// Users can be filtered server-side; schedules need every policy scanned
+		params := make(map[string]string)
+		if target.Type == escalationTargetTypes["user"] {
+			params["user_ids[]"] = target.ID
+		}
+
+		matches := []models.EscalationPolicyTargetMatch{}
+		more := false
+		err = c.PaginateWithContext(ctx, "/escalation_policies", params, models.MaxResults, func(data []byte) (int, error) {
+			var page models.EscalationPoliciesResponse
+			if err := json.Unmarshal(data, &page); err != nil {
+				return 0, err
+			}
+			more = page.More
+			for _, policy := range page.EscalationPolicies {
+				if levels := escalationTargetLevels(policy, target); len(levels) > 0 {
+					matches = append(matches, models.EscalationPolicyTargetMatch{
+						EscalationPolicy: models.EscalationPolicyReference{
+							ID:      policy.ID,
+							Summary: referenceLabel(policy.Name, policy.ID),
+							HTMLURL: policy.HTMLURL,
+						},
+						Levels: levels,
+					})
+				}
+			}
+			return len(page.EscalationPolicies), nil
+		})
+		if err != nil {
+			return toolError(err), nil
+		}
+		// A partial answer could hide a policy that still depends on the target
+		if more {
+			return mcp.NewToolResultError(fmt.Sprintf("scanned the first %d escalation policies but more exist, so the matches would be incomplete", models.MaxResults)), nil
+		}
+
+		return listResult(models.ListResponse[models.EscalationPolicyTargetMatch]{Response: matches}), nil
+	}
+}
+
+// escalationTargetLevels returns the one-based levels of the policy's rules that notify the target
+func escalationTargetLevels(policy models.EscalationPolicy, target models.EscalationTarget) []int {
+	var levels []int
+	for i, rule := range policy.EscalationRules {
+		if indexOfEscalationTarget(rule, target) >= 0 {
+			levels = append(levels, i+1)
+		}
+	}
+	return levels
+}
+
+// defaultEscalationDelayMinutes is the delay used for escalation rules appended by add_escalation_target
+const defaultEscalationDelayMinutes = 30
+
+// escalationTargetTypes maps the target_type argument to the API reference type
+var escalationTargetTypes = map[string]string{
+	"user":     "user_reference",
+	"schedule": "schedule_reference",
+}
+
+// getEscalationTarget reads and validates the target_id and target_type arguments
+func getEscalationTarget(args map[string]any) (models.EscalationTarget, error) {
+	targetID, ok := getString(args, "target_id")
+	if !ok {
+		return models.EscalationTarget{}, fmt.Errorf("target_id is required")
+	}
+	targetType, ok := getString(args, "target_type")
+	if !ok {
+		return models.EscalationTarget{}, fmt.Errorf("target_type is required")
+	}
+	refType, ok := escalationTargetTypes[targetType]
+	if !ok {
+		return models.EscalationTarget{}, fmt.Errorf("invalid target_type '%s': must be 'user' or 'schedule'", targetType)
+	}
+	return models.EscalationTarget{ID: targetID, Type: refType}, nil
+}
+
+// indexOfEscalationTarget returns the position of the target in the rule, or -1
+func indexOfEscalationTarget(rule models.EscalationRule, target models.EscalationTarget) int {
+	for i, t := range rule.Targets {
+		if t.ID == target.ID && t.Type == target.Type {
+			return i
+		}
+	}
+	return -1
+}
+
+// updateEscalationPolicy PUTs the modified policy and returns the updated policy as the tool result
+func updateEscalationPolicy(ctx context.Context, c *client.Client, policy models.EscalationPolicy) *mcp.CallToolResult {
+	var resp models.EscalationPolicyResponse
+	req := models.EscalationPolicyUpdateRequest{EscalationPolicy: policy}
+	if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/escalation_policies/%s", policy.ID), req, &resp); err != nil {
+		return toolError(err)
+	}
+
+	data, _ := json.Marshal(resp.EscalationPolicy)
+	return mcp.NewToolResultText(string(data))
+}
+
+func addEscalationTargetHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		policyID, ok := getString(args, "escalation_policy_id")
+		if !ok {
+			return mcp.NewToolResultError("escalation_policy_id is required"), nil
+		}
+		target, err := getEscalationTarget(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ruleIndex, hasIndex, err := getInteger(args, "rule_index", 0, math.MaxInt)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		delay, hasDelay, err := getInteger(args, "escalation_delay_in_minutes", 1, math.MaxInt)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if !hasDelay {
+			delay = defaultEscalationDelayMinutes
+		}
+
+		// First, get the current policy
+		var current models.EscalationPolicyResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/escalation_policies/%s", policyID), nil, &current); err != nil {
+			return toolError(fmt.Errorf("failed to get escalation policy: %w", err)), nil
+		}
+		policy := current.EscalationPolicy
+		policy.ID = policyID
+
+		if !hasIndex {
+			policy.EscalationRules = append(policy.EscalationRules, models.EscalationRule{
+				EscalationDelayInMinutes: delay,
+				Targets:                  []models.EscalationTarget{target},
+			})
+			return updateEscalationPolicy(ctx, c, policy), nil
+		}
+
+		if ruleIndex >= len(policy.EscalationRules) {
+			return mcp.NewToolResultError(fmt.Sprintf("rule_index %d is out of range: policy has %d rule(s); omit rule_index to append a new rule", ruleIndex, len(policy.EscalationRules))), nil
+		}
+		rule := &policy.EscalationRules[ruleIndex]
+		if indexOfEscalationTarget(*rule, target) >= 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("%s is already a target of rule %d", target.ID, ruleIndex)), nil
+		}
+		rule.Targets = append(rule.Targets, target)
+
+		return updateEscalationPolicy(ctx, c, policy), nil
+	}
+}
+
+func removeEscalationTargetHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		policyID, ok := getString(args, "escalation_policy_id")
+		if !ok {
+			return mcp.NewToolResultError("escalation_policy_id is required"), nil
+		}
+		ruleIndex, ok, err := getInteger(args, "rule_index", 0, math.MaxInt)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if !ok {
+			return mcp.NewToolResultError("rule_index is required"), nil
+		}
+		target, err := getEscalationTarget(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// First, get the current policy
+		var current models.EscalationPolicyResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/escalation_policies/%s", policyID), nil, &current); err != nil {
+			return toolError(fmt.Errorf("failed to get escalation policy: %w", err)), nil
+		}
+		policy := current.EscalationPolicy
+		policy.ID = policyID
+
+		if ruleIndex >= len(policy.EscalationRules) {
+			return mcp.NewToolResultError(fmt.Sprintf("rule_index %d is out of range: policy has %d rule(s)", ruleIndex, len(policy.EscalationRules))), nil
+		}
+		rule := &policy.EscalationRules[ruleIndex]
+		i := indexOfEscalationTarget(*rule, target)
+		if i < 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("%s is not a target of rule %d", target.ID, ruleIndex)), nil
+		}
+		if len(rule.Targets) == 1 {
+			return mcp.NewToolResultError(fmt.Sprintf("cannot remove the only target of rule %d: an escalation rule must have at least one target", ruleIndex)), nil
+		}
+		rule.Targets = append(rule.Targets[:i], rule.Targets[i+1:]...)
+
+		return updateEscalationPolicy(ctx, c, policy), nil
+	}
+}
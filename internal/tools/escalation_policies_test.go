@@ -0,0 +1,416 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// escalationPolicyJSON is a policy with two rules used by the escalation target tests
+const escalationPolicyJSON = `{"escalation_policy":{"id":"PEP1","name":"Platform","escalation_rules":[
+	{"id":"R1","escalation_delay_in_minutes":15,"targets":[{"id":"PUSER1","type":"user_reference"},{"id":"PSCHED1","type":"schedule_reference"}]},
+	{"id":"R2","escalation_delay_in_minutes":30,"targets":[{"id":"PUSER2","type":"user_reference"}]}
+]}}`
+
+// newEscalationPolicyTestClient serves escalationPolicyJSON and records the PUT body in update
+func newEscalationPolicyTestClient(t *testing.T, update *models.EscalationPolicyUpdateRequest) *client.Client {
+	return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, update); err != nil {
+				t.Errorf("Failed to parse update body: %v", err)
+			}
+			w.Write(body)
+			return
+		}
+		w.Write([]byte(escalationPolicyJSON))
+	})
+}
+
+// TestAddEscalationTarget_ExistingRule tests that the target is added to the indexed rule only
+func TestAddEscalationTarget_ExistingRule(t *testing.T) {
+	var update models.EscalationPolicyUpdateRequest
+	c := newEscalationPolicyTestClient(t, &update)
+
+	result, err := addEscalationTargetHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"escalation_policy_id": "PEP1",
+		"rule_index":           float64(1),
+		"target_id":            "PSCHED2",
+		"target_type":          "schedule",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	rules := update.EscalationPolicy.EscalationRules
+	if len(rules) != 2 || len(rules[0].Targets) != 2 {
+		t.Fatalf("Expected the first rule to be unchanged, got %+v", rules)
+	}
+	want := models.EscalationTarget{ID: "PSCHED2", Type: "schedule_reference"}
+	if len(rules[1].Targets) != 2 || rules[1].Targets[1] != want {
+		t.Errorf("Expected %+v appended to rule 1, got %+v", want, rules[1].Targets)
+	}
+}
+
+// TestAddEscalationTarget_NewRule tests that omitting rule_index appends a new rule
+func TestAddEscalationTarget_NewRule(t *testing.T) {
+	var update models.EscalationPolicyUpdateRequest
+	c := newEscalationPolicyTestClient(t, &update)
+
+	result, _ := addEscalationTargetHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"escalation_policy_id": "PEP1",
+		"target_id":            "PUSER3",
+		"target_type":          "user",
+	}))
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	rules := update.EscalationPolicy.EscalationRules
+	if len(rules) != 3 {
+		t.Fatalf("Expected 3 rules, got %d", len(rules))
+	}
+	if rules[2].EscalationDelayInMinutes != defaultEscalationDelayMinutes || len(rules[2].Targets) != 1 || rules[2].Targets[0].ID != "PUSER3" {
+		t.Errorf("Unexpected appended rule %+v", rules[2])
+	}
+}
+
+// TestEscalationTarget_Validation tests that invalid rule indexes and targets are rejected before any update
+func TestEscalationTarget_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(*client.Client) server.ToolHandlerFunc
+		args    map[string]any
+	}{
+		{"add out of range", addEscalationTargetHandler, map[string]any{"rule_index": float64(2), "target_id": "PUSER3", "target_type": "user"}},
+		{"add duplicate", addEscalationTargetHandler, map[string]any{"rule_index": float64(0), "target_id": "PUSER1", "target_type": "user"}},
+		{"add bad type", addEscalationTargetHandler, map[string]any{"target_id": "PUSER3", "target_type": "team"}},
+		{"remove out of range", removeEscalationTargetHandler, map[string]any{"rule_index": float64(5), "target_id": "PUSER1", "target_type": "user"}},
+		{"remove missing target", removeEscalationTargetHandler, map[string]any{"rule_index": float64(0), "target_id": "PUSER2", "target_type": "user"}},
+		{"remove last target", removeEscalationTargetHandler, map[string]any{"rule_index": float64(1), "target_id": "PUSER2", "target_type": "user"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("Expected no update, got %s %s", r.Method, r.URL.Path)
+				}
+				w.Write([]byte(escalationPolicyJSON))
+			})
+
+			tt.args["escalation_policy_id"] = "PEP1"
+			result, _ := tt.handler(c)(context.Background(), newToolRequest(tt.args))
+			if !result.IsError {
+				t.Errorf("Expected an error result, got %v", result.Content)
+			}
+		})
+	}
+}
+
+// TestRemoveEscalationTarget tests that only the matching target is removed
+func TestRemoveEscalationTarget(t *testing.T) {
+	var update models.EscalationPolicyUpdateRequest
+	c := newEscalationPolicyTestClient(t, &update)
+
+	result, _ := removeEscalationTargetHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"escalation_policy_id": "PEP1",
+		"rule_index":           float64(0),
+		"target_id":            "PUSER1",
+		"target_type":          "user",
+	}))
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	targets := update.EscalationPolicy.EscalationRules[0].Targets
+	if len(targets) != 1 || targets[0].ID != "PSCHED1" {
+		t.Errorf("Expected only PSCHED1 to remain, got %+v", targets)
+	}
+}
+
+// TestGetEscalationPolicyOncall tests that on-calls are ordered by escalation
+// level and that an empty result is still explained
+func TestGetEscalationPolicyOncall(t *testing.T) {
+	body := `{"oncalls":[
+		{"escalation_policy":{"id":"PEP1","summary":"Platform"},"escalation_level":2,"user":{"id":"PUSER2","summary":"Bob"}},
+		{"escalation_policy":{"id":"PEP1","summary":"Platform"},"escalation_level":1,"user":{"id":"PUSER1","summary":"Alice"},"schedule":{"id":"PSCHED1","summary":"Primary"}}
+	]}`
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/oncalls" || q.Get("escalation_policy_ids[]") != "PEP1" || q.Get("earliest") != "true" {
+			t.Errorf("Unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Write([]byte(body))
+	})
+	handler := getEscalationPolicyOncallHandler(c)
+
+	result, err := handler(context.Background(), newToolRequest(map[string]any{"escalation_policy_id": "PEP1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	var out models.EscalationPolicyOncall
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Oncalls) != 2 || out.Oncalls[0].UserID != "PUSER1" || out.Oncalls[1].UserID != "PUSER2" {
+		t.Errorf("Expected [PUSER1 PUSER2] ordered by level, got %+v", out.Oncalls)
+	}
+	if out.Oncalls[0].ScheduleName != "Primary" || out.EscalationPolicy.Summary != "Platform" {
+		t.Errorf("Unexpected result: %+v", out)
+	}
+
+	body = `{"oncalls":[]}`
+	result, err = handler(context.Background(), newToolRequest(map[string]any{"escalation_policy_id": "PEP1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out = models.EscalationPolicyOncall{}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if out.Oncalls == nil || len(out.Oncalls) != 0 || out.Summary != "No one is currently on call for escalation policy PEP1" {
+		t.Errorf("Expected an empty result with a summary, got %+v", out)
+	}
+}
+
+// TestGetEscalationPolicyOncall_Paging tests that on-calls are read across
+// pages and that stopping at the result cap is reported in the summary
+func TestGetEscalationPolicyOncall_Paging(t *testing.T) {
+	endless := false
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("limit") != "100" {
+			t.Errorf("Expected limit=100, got %s", r.URL.RawQuery)
+		}
+		if endless {
+			entries := make([]string, 100)
+			for i := range entries {
+				entries[i] = fmt.Sprintf(`{"escalation_level":%d,"user":{"id":"PUSER%d"}}`, i%5+1, i)
+			}
+			fmt.Fprintf(w, `{"oncalls":[%s],"more":true}`, strings.Join(entries, ","))
+			return
+		}
+		if q.Get("offset") == "0" {
+			w.Write([]byte(`{"oncalls":[{"escalation_level":1,"user":{"id":"PUSER1"}}],"more":true}`))
+			return
+		}
+		w.Write([]byte(`{"oncalls":[{"escalation_level":2,"user":{"id":"PUSER2"}}],"more":false}`))
+	})
+	handler := getEscalationPolicyOncallHandler(c)
+
+	result, err := handler(context.Background(), newToolRequest(map[string]any{"escalation_policy_id": "PEP1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var out models.EscalationPolicyOncall
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Oncalls) != 2 || out.OncallsTruncated {
+		t.Errorf("Expected both pages and no truncation, got %+v", out)
+	}
+
+	endless = true
+	result, err = handler(context.Background(), newToolRequest(map[string]any{"escalation_policy_id": "PEP1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out = models.EscalationPolicyOncall{}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Oncalls) != models.MaxResults || !out.OncallsTruncated {
+		t.Errorf("Expected %d on-calls and truncation, got %d (truncated %v)", models.MaxResults, len(out.Oncalls), out.OncallsTruncated)
+	}
+	if !strings.HasSuffix(out.Summary, "; only the first 1000 on-call entries were read, so some levels may be missing") {
+		t.Errorf("Unexpected summary '%s'", out.Summary)
+	}
+}
+
+// TestFindEscalationPoliciesForTarget tests that users are filtered by the API,
+// schedules are matched client-side, and the matching levels are reported
+func TestFindEscalationPoliciesForTarget(t *testing.T) {
+	var userIDs []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/escalation_policies" {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+		userIDs = r.URL.Query()["user_ids[]"]
+		w.Write([]byte(`{"escalation_policies":[
+			{"id":"PEP1","name":"Platform","escalation_rules":[
+				{"targets":[{"id":"PUSER1","type":"user_reference"},{"id":"PSCHED1","type":"schedule_reference"}]},
+				{"targets":[{"id":"PSCHED1","type":"schedule_reference"}]}
+			]},
+			{"id":"PEP2","name":"Payments","escalation_rules":[
+				{"targets":[{"id":"PSCHED2","type":"schedule_reference"}]}
+			]}
+		],"more":false}`))
+	})
+	handler := findEscalationPoliciesForTargetHandler(c)
+
+	result, err := handler(context.Background(), newToolRequest(map[string]any{
+		"target_type": "schedule",
+		"target_id":   "PSCHED1",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if len(userIDs) != 0 {
+		t.Errorf("Expected no user filter for a schedule, got %v", userIDs)
+	}
+	var out models.ListResponse[models.EscalationPolicyTargetMatch]
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Response) != 1 || out.Response[0].EscalationPolicy.ID != "PEP1" || len(out.Response[0].Levels) != 2 || out.Response[0].Levels[1] != 2 {
+		t.Errorf("Expected PEP1 at levels [1 2], got %+v", out.Response)
+	}
+
+	result, err = handler(context.Background(), newToolRequest(map[string]any{
+		"target_type": "user",
+		"target_id":   "PUSER1",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(userIDs) != 1 || userIDs[0] != "PUSER1" {
+		t.Errorf("Expected user_ids[]=PUSER1, got %v", userIDs)
+	}
+	out = models.ListResponse[models.EscalationPolicyTargetMatch]{}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Response) != 1 || out.Response[0].EscalationPolicy.Summary != "Platform" || out.Response[0].Levels[0] != 1 {
+		t.Errorf("Expected Platform at level 1, got %+v", out.Response)
+	}
+}
+
+// TestFindEscalationPoliciesForTarget_Truncated tests that a scan cut short at
+// the result limit is reported as an error rather than a partial list
+func TestFindEscalationPoliciesForTarget_Truncated(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		policies := make([]string, 100)
+		for i := range policies {
+			policies[i] = fmt.Sprintf(`{"id":"PEP%d","escalation_rules":[]}`, offset+i)
+		}
+		fmt.Fprintf(w, `{"escalation_policies":[%s],"more":true}`, strings.Join(policies, ","))
+	})
+
+	result, err := findEscalationPoliciesForTargetHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"target_type": "schedule",
+		"target_id":   "PSCHED1",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Errorf("Expected a truncated scan to be reported as an error, got %v", result.Content)
+	}
+}
+
+// TestSimulateEscalationPath tests that a schedule used at two levels lists
+// only that level's on-call user at each step, and that on-calls are paged
+func TestSimulateEscalationPath(t *testing.T) {
+	var oncallQuery url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/escalation_policies/PEP1":
+			w.Write([]byte(`{"escalation_policy":{"id":"PEP1","escalation_rules":[
+				{"escalation_delay_in_minutes":15,"targets":[{"id":"PSCHED1","type":"schedule_reference"}]},
+				{"escalation_delay_in_minutes":30,"targets":[{"id":"PSCHED1","type":"schedule_reference"},{"id":"PUSER9","type":"user_reference","summary":"Manager"}]}
+			]}}`))
+		case "/oncalls":
+			oncallQuery = r.URL.Query()
+			w.Write([]byte(`{"oncalls":[
+				{"escalation_level":1,"user":{"id":"PUSER1","summary":"Alice"},"schedule":{"id":"PSCHED1","summary":"Primary"}},
+				{"escalation_level":2,"user":{"id":"PUSER2","summary":"Bob"},"schedule":{"id":"PSCHED1","summary":"Primary"}}
+			],"more":false}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	result, err := simulateEscalationPathHandler(c)(context.Background(), newToolRequest(map[string]any{"escalation_policy_id": "PEP1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if oncallQuery.Get("limit") == "" || oncallQuery.Get("earliest") != "true" {
+		t.Errorf("Expected a paged earliest on-call query, got %v", oncallQuery)
+	}
+
+	var out models.ListResponse[models.EscalationPathStep]
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Response) != 2 {
+		t.Fatalf("Expected 2 steps, got %+v", out.Response)
+	}
+	first, second := out.Response[0], out.Response[1]
+	if len(first.Users) != 1 || first.Users[0].UserID != "PUSER1" {
+		t.Errorf("Expected only PUSER1 at level 1, got %+v", first.Users)
+	}
+	if len(second.Users) != 2 || second.Users[0].UserID != "PUSER2" || second.Users[1].UserID != "PUSER9" {
+		t.Errorf("Expected [PUSER2 PUSER9] at level 2, got %+v", second.Users)
+	}
+	if second.StartsAfterMinutes != 15 {
+		t.Errorf("Expected level 2 to start after 15 minutes, got %d", second.StartsAfterMinutes)
+	}
+}
+
+// TestSimulateEscalationPath_Truncated tests that stopping at the on-call
+// result cap is flagged and noted in the summary
+func TestSimulateEscalationPath_Truncated(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/escalation_policies/PEP1":
+			w.Write([]byte(`{"escalation_policy":{"id":"PEP1","escalation_rules":[
+				{"escalation_delay_in_minutes":15,"targets":[{"id":"PSCHED1","type":"schedule_reference"}]}
+			]}}`))
+		case "/oncalls":
+			entries := make([]string, 100)
+			for i := range entries {
+				entries[i] = fmt.Sprintf(`{"escalation_level":1,"user":{"id":"PUSER%d"},"schedule":{"id":"PSCHED%d"}}`, i, i)
+			}
+			fmt.Fprintf(w, `{"oncalls":[%s],"more":true}`, strings.Join(entries, ","))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	result, err := simulateEscalationPathHandler(c)(context.Background(), newToolRequest(map[string]any{"escalation_policy_id": "PEP1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var out escalationPathResponse
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if !out.OncallsTruncated || !strings.Contains(out.Summary, "Only the first 1000 on-call entries were read") {
+		t.Errorf("Expected the on-calls to be reported as truncated, got %+v", out)
+	}
+}
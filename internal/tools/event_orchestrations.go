@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
@@ -29,6 +31,14 @@ func RegisterEventOrchestrationReadTools(s *server.MCPServer, c *client.Client)
 		mcp.WithString("orchestration_id", mcp.Required(), mcp.Description("The unique orchestration ID (e.g., 'E1A2B3C')")),
 	), getEventOrchestrationHandler(c))
 
+	// list_event_orchestration_integrations
+	s.AddTool(mcp.NewTool("list_event_orchestration_integrations",
+		mcp.WithDescription("List the integrations (routing keys) that send events into an event orchestration."),
+		mcp.WithTitleAnnotation("List Orchestration Integrations"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("orchestration_id", mcp.Required(), mcp.Description("The unique orchestration ID (e.g., 'E1A2B3C')")),
+	), listEventOrchestrationIntegrationsHandler(c))
+
 	// get_event_orchestration_router
 	s.AddTool(mcp.NewTool("get_event_orchestration_router",
 		mcp.WithDescription("Get the router rules for an event orchestration. Router rules determine which service an event is routed to based on conditions matching event fields."),
@@ -45,6 +55,25 @@ func RegisterEventOrchestrationReadTools(s *server.MCPServer, c *client.Client)
 		mcp.WithString("orchestration_id", mcp.Required(), mcp.Description("The unique orchestration ID (e.g., 'E1A2B3C')")),
 	), getEventOrchestrationGlobalHandler(c))
 
+	// simulate_event_orchestration
+	s.AddTool(mcp.NewTool("simulate_event_orchestration",
+		mcp.WithDescription("Test what an orchestration would do with a sample event before changing its rules. Fetches the current rules and evaluates them locally (PagerDuty has no simulation API), returning the matched rules and resulting actions, or the catch-all if nothing matches. Supports the PCL operators matches, matches part, matches regex, exists, not, and, or."),
+		mcp.WithTitleAnnotation("Simulate Event Orchestration"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("event", mcp.Required(), mcp.Description("Sample event as a JSON object. Condition paths like event.summary or event.custom_details.host are resolved against it (e.g., '{\"summary\":\"DB down\",\"source\":\"db-1\",\"severity\":\"critical\"}')")),
+		mcp.WithString("path", mcp.Description("Which rules to evaluate (default: router)"), mcp.Enum("router", "global", "service")),
+		mcp.WithString("orchestration_id", mcp.Description("The orchestration ID, required for the router and global paths (e.g., 'E1A2B3C')")),
+		mcp.WithString("service_id", mcp.Description("The service ID, required for the service path (e.g., 'PSERVICE123')")),
+	), simulateEventOrchestrationHandler(c))
+
+	// validate_orchestration_condition
+	s.AddTool(mcp.NewTool("validate_orchestration_condition",
+		mcp.WithDescription("Check an event orchestration condition expression for mistakes before adding it to a rule, since a malformed condition silently never matches. Reports unbalanced quotes or parentheses, unknown operators, missing values, paths that don't start with event. or raw_event., and invalid regexes. Supports the PCL operators matches, matches part, matches regex, exists, not, and, or."),
+		mcp.WithTitleAnnotation("Validate Orchestration Condition"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("expression", mcp.Required(), mcp.Description("The condition expression (e.g., 'event.summary matches part \"database\" and event.severity matches \"critical\"')")),
+	), validateOrchestrationConditionHandler())
+
 	// get_event_orchestration_service
 	s.AddTool(mcp.NewTool("get_event_orchestration_service",
 		mcp.WithDescription("Get the service-level orchestration rules for a specific service. These rules process events after routing and can set severity, add notes, or trigger automations."),
@@ -52,6 +81,14 @@ func RegisterEventOrchestrationReadTools(s *server.MCPServer, c *client.Client)
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithString("service_id", mcp.Required(), mcp.Description("The unique service ID (e.g., 'PDSVC123')")),
 	), getEventOrchestrationServiceHandler(c))
+
+	// get_service_event_rules
+	s.AddTool(mcp.NewTool("get_service_event_rules",
+		mcp.WithDescription("Get the event rules for a service: the conditions PagerDuty checks on each incoming alert and what it does when they match (set severity or priority, suppress, add notes, run automations). Includes a count of rules. Same data as get_event_orchestration_service, starting from the service."),
+		mcp.WithTitleAnnotation("Get Service Event Rules"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("service_id", mcp.Required(), mcp.Description("The unique service ID (e.g., 'PDSVC123')")),
+	), getServiceEventRulesHandler(c))
 }
 
 // RegisterEventOrchestrationWriteTools registers write event orchestration tools
@@ -70,9 +107,50 @@ func RegisterEventOrchestrationWriteTools(s *server.MCPServer, c *client.Client)
 		mcp.WithTitleAnnotation("Add Router Rule"),
 		mcp.WithString("orchestration_id", mcp.Required(), mcp.Description("The unique orchestration ID (e.g., 'E1A2B3C')")),
 		mcp.WithString("label", mcp.Description("Human-readable label for the rule (e.g., 'Route database alerts')")),
-		mcp.WithString("conditions", mcp.Description("JSON array of conditions. Each condition has 'expression' (JEXL format, e.g., 'event.source matches \"database\"')")),
+		mcp.WithString("conditions", mcp.Description("JSON array of conditions. Each condition has 'expression' (PCL format, e.g., 'event.source matches \"database\"'). Expressions are checked as in validate_orchestration_condition before the rule is saved.")),
+		mcp.WithBoolean("skip_condition_check", mcp.Description("Save the conditions without checking them, for PCL features the check doesn't understand")),
 		mcp.WithString("route_to", mcp.Required(), mcp.Description("The service ID to route matching events to (e.g., 'PDSVC123')")),
 	), appendEventOrchestrationRouterRuleHandler(c))
+
+	// reorder_event_orchestration_router_rules
+	s.AddTool(mcp.NewTool("reorder_event_orchestration_router_rules",
+		mcp.WithDescription("Reorder the routing rules in the first rule set of an event orchestration. The first matching rule wins, so order determines routing precedence. Every existing rule ID must be listed exactly once."),
+		mcp.WithTitleAnnotation("Reorder Router Rules"),
+		mcp.WithString("orchestration_id", mcp.Required(), mcp.Description("The unique orchestration ID (e.g., 'E1A2B3C')")),
+		mcp.WithString("rule_ids", mcp.Required(), mcp.Description("Comma-separated rule IDs in the desired order (e.g., 'a1b2c3d4,e5f6g7h8'). Get IDs from get_event_orchestration_router.")),
+	), reorderEventOrchestrationRouterRulesHandler(c))
+
+	// set_event_orchestration_rule_enabled
+	s.AddTool(mcp.NewTool("set_event_orchestration_rule_enabled",
+		mcp.WithDescription("Enable or disable a single routing rule in an event orchestration, leaving every other rule unchanged. Disabling a rule is a quick way to silence a noisy route during an incident; events then fall through to later rules or the catch-all."),
+		mcp.WithTitleAnnotation("Enable or Disable Router Rule"),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithString("orchestration_id", mcp.Required(), mcp.Description("The unique orchestration ID (e.g., 'E1A2B3C')")),
+		mcp.WithString("rule_id", mcp.Required(), mcp.Description("The rule ID to change. Get IDs from get_event_orchestration_router.")),
+		mcp.WithBoolean("enabled", mcp.Required(), mcp.Description("true to enable the rule, false to disable it")),
+	), setEventOrchestrationRuleEnabledHandler(c))
+
+	// append_event_orchestration_global_rule
+	s.AddTool(mcp.NewTool("append_event_orchestration_global_rule",
+		mcp.WithDescription("Add a new global rule to an event orchestration without modifying existing rules. Global rules run before routing and are where suppression, drop, and severity rules belong. The rule will be appended to the first rule set."),
+		mcp.WithTitleAnnotation("Add Global Orchestration Rule"),
+		mcp.WithString("orchestration_id", mcp.Required(), mcp.Description("The unique orchestration ID (e.g., 'E1A2B3C')")),
+		mcp.WithString("label", mcp.Description("Human-readable label for the rule (e.g., 'Suppress staging alerts')")),
+		mcp.WithString("conditions", mcp.Description("JSON array of conditions. Each condition has 'expression' (PCL format, e.g., 'event.source matches \"staging\"'). Expressions are checked as in validate_orchestration_condition before the rule is saved.")),
+		mcp.WithBoolean("skip_condition_check", mcp.Description("Save the conditions without checking them, for PCL features the check doesn't understand")),
+		mcp.WithBoolean("suppress", mcp.Description("Suppress matching events so they create suppressed alerts without notifying anyone")),
+		mcp.WithBoolean("drop_event", mcp.Description("Drop matching events entirely")),
+		mcp.WithString("severity", mcp.Description("Set the severity of matching events"), mcp.Enum("info", "warning", "error", "critical")),
+	), appendEventOrchestrationGlobalRuleHandler(c))
+
+	// rotate_event_orchestration_integration_key
+	s.AddTool(mcp.NewTool("rotate_event_orchestration_integration_key",
+		mcp.WithDescription("WARNING: DESTRUCTIVE - Rotate a leaked routing key. Creates a new integration with the same label, then deletes the old one so its routing key stops accepting events. Senders must be updated to the new routing key returned. The old routing key cannot be restored."),
+		mcp.WithTitleAnnotation("Rotate Orchestration Routing Key"),
+		mcp.WithDestructiveHintAnnotation(true),
+		mcp.WithString("orchestration_id", mcp.Required(), mcp.Description("The unique orchestration ID (e.g., 'E1A2B3C')")),
+		mcp.WithString("integration_id", mcp.Required(), mcp.Description("The integration ID whose routing key should be rotated. Get IDs from list_event_orchestration_integrations.")),
+	), rotateEventOrchestrationIntegrationKeyHandler(c))
 }
 
 func listEventOrchestrationsHandler(c *client.Client) server.ToolHandlerFunc {
@@ -80,18 +158,19 @@ func listEventOrchestrationsHandler(c *client.Client) server.ToolHandlerFunc {
 		args := getArgs(request)
 		params := make(map[string]string)
 
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
 		}
 
 		var resp models.EventOrchestrationsResponse
-		if err := c.GetJSON("/event_orchestrations", params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, "/event_orchestrations", params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		result := models.ListResponse[models.EventOrchestration]{Response: resp.Orchestrations}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		result := models.ListResponse[models.EventOrchestration]{Response: resp.Orchestrations}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
 	}
 }
 
@@ -104,8 +183,8 @@ func getEventOrchestrationHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.EventOrchestrationResponse
-		if err := c.GetJSON(fmt.Sprintf("/event_orchestrations/%s", orchestrationID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s", orchestrationID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Orchestration)
@@ -113,6 +192,24 @@ func getEventOrchestrationHandler(c *client.Client) server.ToolHandlerFunc {
 	}
 }
 
+func listEventOrchestrationIntegrationsHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		orchestrationID, ok := getString(args, "orchestration_id")
+		if !ok {
+			return mcp.NewToolResultError("orchestration_id is required"), nil
+		}
+
+		var resp models.EventOrchestrationIntegrationsResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/integrations", orchestrationID), nil, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		result := models.ListResponse[models.EventOrchestrationIntegration]{Response: resp.Integrations}
+		return listResult(result), nil
+	}
+}
+
 func getEventOrchestrationRouterHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
@@ -122,8 +219,8 @@ func getEventOrchestrationRouterHandler(c *client.Client) server.ToolHandlerFunc
 		}
 
 		var resp models.EventOrchestrationRouterResponse
-		if err := c.GetJSON(fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.OrchestrationPath)
@@ -140,8 +237,8 @@ func getEventOrchestrationGlobalHandler(c *client.Client) server.ToolHandlerFunc
 		}
 
 		var resp models.EventOrchestrationGlobalResponse
-		if err := c.GetJSON(fmt.Sprintf("/event_orchestrations/%s/global", orchestrationID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/global", orchestrationID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.OrchestrationPath)
@@ -158,8 +255,8 @@ func getEventOrchestrationServiceHandler(c *client.Client) server.ToolHandlerFun
 		}
 
 		var resp models.EventOrchestrationServiceResponse
-		if err := c.GetJSON(fmt.Sprintf("/event_orchestrations/services/%s", serviceID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/services/%s", serviceID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.OrchestrationPath)
@@ -167,6 +264,103 @@ func getEventOrchestrationServiceHandler(c *client.Client) server.ToolHandlerFun
 	}
 }
 
+func getServiceEventRulesHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		serviceID, ok := getString(args, "service_id")
+		if !ok {
+			return mcp.NewToolResultError("service_id is required"), nil
+		}
+
+		var resp models.EventOrchestrationServiceResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/services/%s", serviceID), nil, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		path := resp.OrchestrationPath
+		rules := models.ServiceEventRules{
+			ServiceID: serviceID,
+			Sets:      path.Sets,
+			CatchAll:  path.CatchAll,
+		}
+		if rules.Sets == nil {
+			rules.Sets = []models.EventOrchestrationRuleSet{}
+		}
+		for _, set := range path.Sets {
+			for _, rule := range set.Rules {
+				rules.RuleCount++
+				if !rule.Disabled {
+					rules.EnabledCount++
+				}
+			}
+		}
+		rules.Summary = fmt.Sprintf("Service %s has %d event rule(s) in %d set(s), %d enabled", serviceID, rules.RuleCount, len(path.Sets), rules.EnabledCount)
+		if rules.RuleCount == 0 {
+			rules.Summary = fmt.Sprintf("Service %s has no event rules; every event gets the catch-all actions", serviceID)
+		}
+
+		data, _ := json.Marshal(rules)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func simulateEventOrchestrationHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		eventStr, ok := getString(args, "event")
+		if !ok {
+			return mcp.NewToolResultError("event is required"), nil
+		}
+
+		var event map[string]any
+		if err := json.Unmarshal([]byte(eventStr), &event); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid event JSON: %v", err)), nil
+		}
+
+		path := "router"
+		if v, ok := getString(args, "path"); ok {
+			path = v
+		}
+
+		var sets []models.EventOrchestrationRuleSet
+		var catchAll *models.EventOrchestrationCatchAll
+		switch path {
+		case "service":
+			serviceID, ok := getString(args, "service_id")
+			if !ok {
+				return mcp.NewToolResultError("service_id is required for the service path"), nil
+			}
+			var resp models.EventOrchestrationServiceResponse
+			if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/services/%s", serviceID), nil, &resp); err != nil {
+				return toolError(err), nil
+			}
+			sets, catchAll = resp.OrchestrationPath.Sets, resp.OrchestrationPath.CatchAll
+		case "router", "global":
+			orchestrationID, ok := getString(args, "orchestration_id")
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("orchestration_id is required for the %s path", path)), nil
+			}
+			// Router and global paths share the same shape
+			var resp models.EventOrchestrationRouterResponse
+			if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/%s", orchestrationID, path), nil, &resp); err != nil {
+				return toolError(err), nil
+			}
+			sets, catchAll = resp.OrchestrationPath.Sets, resp.OrchestrationPath.CatchAll
+		default:
+			return mcp.NewToolResultError("path must be one of: router, global, service"), nil
+		}
+
+		result, err := simulateOrchestration(sets, catchAll, event)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to evaluate rules: %v", err)), nil
+		}
+		result.Path = path
+
+		data, _ := json.Marshal(result)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
 func updateEventOrchestrationRouterHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
@@ -186,8 +380,8 @@ func updateEventOrchestrationRouterHandler(c *client.Client) server.ToolHandlerF
 		}
 
 		var resp models.EventOrchestrationRouterResponse
-		if err := c.PutJSON(fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), config, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), config, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.OrchestrationPath)
@@ -208,14 +402,20 @@ func appendEventOrchestrationRouterRuleHandler(c *client.Client) server.ToolHand
 			return mcp.NewToolResultError("route_to is required"), nil
 		}
 
+		conditions, err := getConditions(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
 		// First, get the current router config
 		var currentResp models.EventOrchestrationRouterResponse
-		if err := c.GetJSON(fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), nil, &currentResp); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to get current router: %v", err)), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), nil, &currentResp); err != nil {
+			return toolError(fmt.Errorf("failed to get current router: %w", err)), nil
 		}
 
 		// Create the new rule
 		newRule := models.EventOrchestrationRule{
+			Conditions: conditions,
 			Actions: models.EventOrchestrationRuleActions{
 				RouteTo: routeTo,
 			},
@@ -225,14 +425,6 @@ func appendEventOrchestrationRouterRuleHandler(c *client.Client) server.ToolHand
 			newRule.Label = v
 		}
 
-		if v, ok := getString(args, "conditions"); ok {
-			var conditions []models.EventOrchestrationRuleCondition
-			if err := json.Unmarshal([]byte(v), &conditions); err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("invalid conditions JSON: %v", err)), nil
-			}
-			newRule.Conditions = conditions
-		}
-
 		// Append the new rule to the first set
 		if len(currentResp.OrchestrationPath.Sets) > 0 {
 			currentResp.OrchestrationPath.Sets[0].Rules = append(currentResp.OrchestrationPath.Sets[0].Rules, newRule)
@@ -247,11 +439,318 @@ func appendEventOrchestrationRouterRuleHandler(c *client.Client) server.ToolHand
 		}
 
 		var resp models.EventOrchestrationRouterResponse
-		if err := c.PutJSON(fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), updateReq, &resp); err != nil {
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), updateReq, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.OrchestrationPath)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func reorderEventOrchestrationRouterRulesHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		orchestrationID, ok := getString(args, "orchestration_id")
+		if !ok {
+			return mcp.NewToolResultError("orchestration_id is required"), nil
+		}
+
+		ruleIDs, ok := getStringArray(args, "rule_ids")
+		if !ok {
+			return mcp.NewToolResultError("rule_ids is required"), nil
+		}
+
+		// First, get the current router config. Rules are kept as raw JSON so
+		// they are written back with every action, such as dynamic_route_to.
+		var currentResp models.EventOrchestrationRawPathResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), nil, &currentResp); err != nil {
+			return toolError(fmt.Errorf("failed to get current router: %w", err)), nil
+		}
+		if len(currentResp.OrchestrationPath.Sets) == 0 {
+			return mcp.NewToolResultError("orchestration router has no rule sets to reorder"), nil
+		}
+
+		reordered, err := reorderRules(currentResp.OrchestrationPath.Sets[0].Rules, ruleIDs)
+		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
+		currentResp.OrchestrationPath.Sets[0].Rules = reordered
+
+		// Update the router
+		updateReq := models.EventOrchestrationRawPathUpdateRequest{OrchestrationPath: currentResp.OrchestrationPath}
+
+		var resp models.EventOrchestrationRawPathResponse
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), updateReq, &resp); err != nil {
+			return toolError(err), nil
+		}
 
 		data, _ := json.Marshal(resp.OrchestrationPath)
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
+
+// rawRuleID returns the id of a rule kept as raw JSON
+func rawRuleID(rule json.RawMessage) string {
+	var r struct {
+		ID string `json:"id"`
+	}
+	_ = json.Unmarshal(rule, &r)
+	return r.ID
+}
+
+// reorderRules returns rules in the order given by ruleIDs. Every rule must be
+// listed exactly once so a reorder can never drop or duplicate a rule.
+func reorderRules(rules []json.RawMessage, ruleIDs []string) ([]json.RawMessage, error) {
+	byID := make(map[string]json.RawMessage, len(rules))
+	for _, rule := range rules {
+		byID[rawRuleID(rule)] = rule
+	}
+
+	reordered := make([]json.RawMessage, 0, len(rules))
+	seen := make(map[string]bool, len(ruleIDs))
+	for _, id := range ruleIDs {
+		rule, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("rule '%s' does not exist in the first rule set", id)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("rule '%s' is listed more than once", id)
+		}
+		seen[id] = true
+		reordered = append(reordered, rule)
+	}
+
+	var missing []string
+	for _, rule := range rules {
+		if id := rawRuleID(rule); !seen[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("rule_ids must list every existing rule; missing: %s", strings.Join(missing, ", "))
+	}
+	return reordered, nil
+}
+
+func setEventOrchestrationRuleEnabledHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		orchestrationID, ok := getString(args, "orchestration_id")
+		if !ok {
+			return mcp.NewToolResultError("orchestration_id is required"), nil
+		}
+
+		ruleID, ok := getString(args, "rule_id")
+		if !ok {
+			return mcp.NewToolResultError("rule_id is required"), nil
+		}
+
+		enabled, ok := getBool(args, "enabled")
+		if !ok {
+			return mcp.NewToolResultError("enabled is required"), nil
+		}
+
+		// Rules are kept as raw JSON and only the target rule's disabled flag is
+		// changed, so every other rule is written back exactly as it was read
+		var currentResp models.EventOrchestrationRawPathResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), nil, &currentResp); err != nil {
+			return toolError(fmt.Errorf("failed to get current router: %w", err)), nil
+		}
+
+		var rule *json.RawMessage
+		sets := currentResp.OrchestrationPath.Sets
+		for i := range sets {
+			for j := range sets[i].Rules {
+				if rawRuleID(sets[i].Rules[j]) == ruleID {
+					rule = &sets[i].Rules[j]
+				}
+			}
+		}
+		if rule == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("rule '%s' not found in orchestration %s router; use get_event_orchestration_router to list rule IDs", ruleID, orchestrationID)), nil
+		}
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(*rule, &fields); err != nil {
+			return toolError(fmt.Errorf("failed to parse rule '%s': %w", ruleID, err)), nil
+		}
+		var disabled bool
+		if v, ok := fields["disabled"]; ok {
+			_ = json.Unmarshal(v, &disabled)
+		}
+
+		// Nothing to write when the rule is already in the requested state
+		if disabled == !enabled {
+			data, _ := json.Marshal(currentResp.OrchestrationPath)
+			return mcp.NewToolResultText(string(data)), nil
+		}
+		fields["disabled"] = json.RawMessage(strconv.FormatBool(!enabled))
+		updated, err := json.Marshal(fields)
+		if err != nil {
+			return toolError(fmt.Errorf("failed to encode rule '%s': %w", ruleID, err)), nil
+		}
+		*rule = updated
+
+		updateReq := models.EventOrchestrationRawPathUpdateRequest{OrchestrationPath: currentResp.OrchestrationPath}
+
+		var resp models.EventOrchestrationRawPathResponse
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/router", orchestrationID), updateReq, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.OrchestrationPath)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func appendEventOrchestrationGlobalRuleHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		orchestrationID, ok := getString(args, "orchestration_id")
+		if !ok {
+			return mcp.NewToolResultError("orchestration_id is required"), nil
+		}
+
+		// Build the rule actions; a rule with no actions would be a no-op
+		var actions models.EventOrchestrationRuleActions
+		if v, ok := getBool(args, "suppress"); ok {
+			actions.Suppress = v
+		}
+		if v, ok := getBool(args, "drop_event"); ok {
+			actions.DropEvent = v
+		}
+		if v, ok := getString(args, "severity"); ok {
+			actions.Severity = v
+		}
+		if !actions.Suppress && !actions.DropEvent && actions.Severity == "" {
+			return mcp.NewToolResultError("at least one action is required: suppress, drop_event, or severity"), nil
+		}
+
+		conditions, err := getConditions(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// First, get the current global config. Existing rules are kept as raw
+		// JSON so actions the typed model doesn't cover are written back intact.
+		var currentResp models.EventOrchestrationRawPathResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/global", orchestrationID), nil, &currentResp); err != nil {
+			return toolError(fmt.Errorf("failed to get current global rules: %w", err)), nil
+		}
+
+		// Create the new rule
+		newRule := models.EventOrchestrationRule{Conditions: conditions, Actions: actions}
+
+		if v, ok := getString(args, "label"); ok {
+			newRule.Label = v
+		}
+		rule, err := json.Marshal(newRule)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to encode rule: %v", err)), nil
+		}
+
+		// Append the new rule to the first set, creating the default "start" set
+		// when the orchestration has no global rules yet
+		path := currentResp.OrchestrationPath
+		if len(path.Sets) == 0 {
+			path.Sets = []models.EventOrchestrationRawRuleSet{{ID: "start"}}
+		}
+		path.Sets[0].Rules = append(path.Sets[0].Rules, rule)
+
+		// Update the global rules
+		updateReq := models.EventOrchestrationRawPathUpdateRequest{OrchestrationPath: path}
+
+		var resp models.EventOrchestrationRawPathResponse
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/event_orchestrations/%s/global", orchestrationID), updateReq, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.OrchestrationPath)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func rotateEventOrchestrationIntegrationKeyHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		orchestrationID, ok := getString(args, "orchestration_id")
+		if !ok {
+			return mcp.NewToolResultError("orchestration_id is required"), nil
+		}
+
+		integrationID, ok := getString(args, "integration_id")
+		if !ok {
+			return mcp.NewToolResultError("integration_id is required"), nil
+		}
+
+		// PagerDuty has no rotate endpoint, so the key is rotated by replacing the
+		// integration. Fetch the old one first so the replacement keeps its label.
+		path := fmt.Sprintf("/event_orchestrations/%s/integrations", orchestrationID)
+		var current models.EventOrchestrationIntegrationResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("%s/%s", path, integrationID), nil, &current); err != nil {
+			return toolError(fmt.Errorf("failed to get integration: %w", err)), nil
+		}
+
+		createReq := models.EventOrchestrationIntegrationRequest{
+			Integration: models.EventOrchestrationIntegrationCreate{Label: current.Integration.Label},
+		}
+		var created models.EventOrchestrationIntegrationResponse
+		if err := c.PostJSONWithContext(ctx, path, createReq, &created); err != nil {
+			return toolError(fmt.Errorf("failed to create replacement integration: %w", err)), nil
+		}
+
+		// Only revoke the old key once the new one exists
+		if _, err := c.DeleteWithContext(ctx, fmt.Sprintf("%s/%s", path, integrationID)); err != nil {
+			return toolError(fmt.Errorf("created integration %s but failed to delete %s, so the old routing key is still active: %w", created.Integration.ID, integrationID, err)), nil
+		}
+
+		result := models.EventOrchestrationIntegrationRotation{
+			Integration:          created.Integration,
+			RevokedIntegrationID: integrationID,
+		}
+		data, _ := json.Marshal(result)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func validateOrchestrationConditionHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		expression, ok := getString(args, "expression")
+		if !ok {
+			return mcp.NewToolResultError("expression is required"), nil
+		}
+
+		result := models.ConditionValidation{Expression: expression, Valid: true}
+		if err := validateCondition(expression); err != nil {
+			result.Valid = false
+			result.Error = err.Error()
+		}
+
+		data, _ := json.Marshal(result)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+// getConditions reads the conditions argument of the append rule tools and,
+// unless skip_condition_check is set, rejects expressions that can never match
+func getConditions(args map[string]any) ([]models.EventOrchestrationRuleCondition, error) {
+	v, ok := getString(args, "conditions")
+	if !ok {
+		return nil, nil
+	}
+	var conditions []models.EventOrchestrationRuleCondition
+	if err := json.Unmarshal([]byte(v), &conditions); err != nil {
+		return nil, fmt.Errorf("invalid conditions JSON: %v", err)
+	}
+	if skip, _ := getBool(args, "skip_condition_check"); skip {
+		return conditions, nil
+	}
+	for i, condition := range conditions {
+		if err := validateCondition(condition.Expression); err != nil {
+			return nil, fmt.Errorf("invalid condition %d (%s): %v. Fix the expression, or set skip_condition_check if it uses PCL features the check doesn't understand", i+1, condition.Expression, err)
+		}
+	}
+	return conditions, nil
+}
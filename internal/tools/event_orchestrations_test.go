@@ -0,0 +1,408 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestAppendGlobalRule_EmptySets tests that a default set is created when the orchestration has no global rules
+func TestAppendGlobalRule_EmptySets(t *testing.T) {
+	var update models.EventOrchestrationRouterUpdateRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &update); err != nil {
+				t.Errorf("Failed to parse update body: %v", err)
+			}
+			w.Write(body)
+			return
+		}
+		w.Write([]byte(`{"orchestration_path":{"type":"global","sets":[],"catch_all":{"actions":{}}}}`))
+	})
+
+	result, err := appendEventOrchestrationGlobalRuleHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"orchestration_id": "E1A2B3C",
+		"label":            "Suppress staging",
+		"conditions":       `[{"expression":"event.source matches 'staging'"}]`,
+		"suppress":         true,
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	sets := update.OrchestrationPath.Sets
+	if len(sets) != 1 || sets[0].ID != "start" {
+		t.Fatalf("Expected a single 'start' set, got %+v", sets)
+	}
+	if len(sets[0].Rules) != 1 || !sets[0].Rules[0].Actions.Suppress {
+		t.Errorf("Expected the suppress rule to be appended, got %+v", sets[0].Rules)
+	}
+	if update.OrchestrationPath.CatchAll == nil {
+		t.Error("Expected catch_all to be preserved")
+	}
+}
+
+// TestAppendGlobalRule_RequiresAction tests that a rule without any action is rejected
+func TestAppendGlobalRule_RequiresAction(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no API call, got %s %s", r.Method, r.URL.Path)
+	})
+
+	result, _ := appendEventOrchestrationGlobalRuleHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"orchestration_id": "E1A2B3C",
+	}))
+	if !result.IsError {
+		t.Error("Expected IsError to be true")
+	}
+}
+
+// TestAppendGlobalRule_KeepsExistingActions tests that actions on existing
+// rules and the catch-all that the typed rule model doesn't cover are written back
+func TestAppendGlobalRule_KeepsExistingActions(t *testing.T) {
+	var update struct {
+		OrchestrationPath struct {
+			Sets []struct {
+				Rules []map[string]any `json:"rules"`
+			} `json:"sets"`
+			CatchAll map[string]any `json:"catch_all"`
+		} `json:"orchestration_path"`
+	}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &update); err != nil {
+				t.Errorf("Failed to parse update body: %v", err)
+			}
+			w.Write(body)
+			return
+		}
+		w.Write([]byte(`{"orchestration_path":{"type":"global","sets":[{"id":"start","rules":[
+			{"id":"R1","actions":{"escalation_policy":"PEP1","automation_action":{"name":"Restart","url":"https://example.com/restart","auto_send":true}}}
+		]}],"catch_all":{"actions":{"pagerduty_automation_action":{"action_id":"PACT1"}}}}}`))
+	})
+
+	result, err := appendEventOrchestrationGlobalRuleHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"orchestration_id": "E1A2B3C",
+		"conditions":       `[{"expression":"event.source matches 'staging'"}]`,
+		"suppress":         true,
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	sets := update.OrchestrationPath.Sets
+	if len(sets) != 1 || len(sets[0].Rules) != 2 {
+		t.Fatalf("Expected the existing rule plus the new one, got %+v", sets)
+	}
+	actions, _ := sets[0].Rules[0]["actions"].(map[string]any)
+	if actions["escalation_policy"] != "PEP1" || actions["automation_action"] == nil {
+		t.Errorf("Expected the existing rule's actions to be kept, got %+v", actions)
+	}
+	catchAll, _ := update.OrchestrationPath.CatchAll["actions"].(map[string]any)
+	if catchAll["pagerduty_automation_action"] == nil {
+		t.Errorf("Expected the catch-all actions to be kept, got %+v", update.OrchestrationPath.CatchAll)
+	}
+}
+
+// TestReorderRules tests reordering and the errors for unknown, duplicate, and omitted rule IDs
+func TestReorderRules(t *testing.T) {
+	rules := []json.RawMessage{json.RawMessage(`{"id":"r1"}`), json.RawMessage(`{"id":"r2"}`), json.RawMessage(`{"id":"r3"}`)}
+
+	reordered, err := reorderRules(rules, []string{"r3", "r1", "r2"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if rawRuleID(reordered[0]) != "r3" || rawRuleID(reordered[1]) != "r1" || rawRuleID(reordered[2]) != "r2" {
+		t.Errorf("Expected order [r3 r1 r2], got %+v", reordered)
+	}
+
+	tests := map[string][]string{
+		"does not exist":        {"r1", "r2", "r9"},
+		"listed more than once": {"r1", "r1", "r2", "r3"},
+		"missing: r3":           {"r2", "r1"},
+	}
+	for want, ids := range tests {
+		if _, err := reorderRules(rules, ids); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error containing '%s' for %v, got %v", want, ids, err)
+		}
+	}
+}
+
+// TestReorderRouterRules_KeepsDynamicRoute tests that a dynamic-route rule
+// is written back with its actions when the rules are reordered
+func TestReorderRouterRules_KeepsDynamicRoute(t *testing.T) {
+	var update struct {
+		OrchestrationPath struct {
+			Sets []struct {
+				Rules []map[string]any `json:"rules"`
+			} `json:"sets"`
+		} `json:"orchestration_path"`
+	}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &update); err != nil {
+				t.Errorf("Failed to parse update body: %v", err)
+			}
+			w.Write(body)
+			return
+		}
+		w.Write([]byte(`{"orchestration_path":{"type":"router","sets":[{"id":"start","rules":[
+			{"id":"R1","actions":{"route_to":"PSVC1"}},
+			{"id":"R2","actions":{"dynamic_route_to":{"lookup_by":"service_id","source":"event.custom_details.pd_service_id","regex":"(.*)"}}}
+		]}],"catch_all":{"actions":{"route_to":"unrouted"}}}}`))
+	})
+
+	result, err := reorderEventOrchestrationRouterRulesHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"orchestration_id": "E1A2B3C",
+		"rule_ids":         "R2,R1",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	rules := update.OrchestrationPath.Sets[0].Rules
+	if len(rules) != 2 || rules[0]["id"] != "R2" {
+		t.Fatalf("Expected R2 first, got %+v", rules)
+	}
+	actions, _ := rules[0]["actions"].(map[string]any)
+	if actions["dynamic_route_to"] == nil {
+		t.Errorf("Expected dynamic_route_to to be kept, got %+v", actions)
+	}
+}
+
+// TestGetServiceEventRules tests that rules across sets are counted and disabled rules are excluded from the enabled count
+func TestGetServiceEventRules(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/event_orchestrations/services/PSVC1" {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"orchestration_path":{"type":"service","sets":[
+			{"id":"start","rules":[{"id":"R1","label":"Critical DB"},{"id":"R2","disabled":true}]},
+			{"id":"set-2","rules":[{"id":"R3"}]}
+		],"catch_all":{"actions":{}}}}`))
+	})
+
+	result, err := getServiceEventRulesHandler(c)(context.Background(), newToolRequest(map[string]any{"service_id": "PSVC1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out models.ServiceEventRules
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if out.RuleCount != 3 || out.EnabledCount != 2 || len(out.Sets) != 2 {
+		t.Errorf("Expected 3 rules (2 enabled) in 2 sets, got %+v", out)
+	}
+	if out.Summary != "Service PSVC1 has 3 event rule(s) in 2 set(s), 2 enabled" {
+		t.Errorf("Unexpected summary '%s'", out.Summary)
+	}
+}
+
+// TestSetEventOrchestrationRuleEnabled tests that only the matching rule is
+// disabled, other rules keep every action, and unknown rules are rejected
+func TestSetEventOrchestrationRuleEnabled(t *testing.T) {
+	var update models.EventOrchestrationRouterUpdateRequest
+	var raw []byte
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			raw = body
+			if err := json.Unmarshal(body, &update); err != nil {
+				t.Errorf("Failed to parse update body: %v", err)
+			}
+			w.Write(body)
+			return
+		}
+		w.Write([]byte(`{"orchestration_path":{"type":"router","sets":[{"id":"start","rules":[
+			{"id":"R1","actions":{"dynamic_route_to":{"lookup_by":"service_id","source":"event.custom_details.pd_service_id","regex":"(.*)"}}},
+			{"id":"R2","actions":{"route_to":"PSVC2"}}
+		]}],"catch_all":{"actions":{"route_to":"unrouted"}}}}`))
+	})
+
+	result, err := setEventOrchestrationRuleEnabledHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"orchestration_id": "E1A2B3C",
+		"rule_id":          "R2",
+		"enabled":          false,
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	rules := update.OrchestrationPath.Sets[0].Rules
+	if len(rules) != 2 || rules[0].Disabled || !rules[1].Disabled {
+		t.Errorf("Expected only R2 disabled, got %+v", rules)
+	}
+	if update.OrchestrationPath.CatchAll == nil || update.OrchestrationPath.CatchAll.Actions.RouteTo != "unrouted" {
+		t.Errorf("Expected catch-all to be preserved, got %+v", update.OrchestrationPath.CatchAll)
+	}
+	if !strings.Contains(string(raw), `"dynamic_route_to":{"lookup_by":"service_id"`) {
+		t.Errorf("Expected R1's dynamic_route_to to be kept, got %s", raw)
+	}
+
+	result, err = setEventOrchestrationRuleEnabledHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"orchestration_id": "E1A2B3C",
+		"rule_id":          "R9",
+		"enabled":          false,
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an unknown rule to be rejected")
+	}
+}
+
+// TestAppendRouterRule_ConditionCheck tests that a malformed condition is
+// rejected before any API call unless skip_condition_check is set
+func TestAppendRouterRule_ConditionCheck(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			w.Write(body)
+			return
+		}
+		w.Write([]byte(`{"orchestration_path":{"type":"router","sets":[{"id":"start","rules":[]}],"catch_all":{"actions":{}}}}`))
+	})
+	args := map[string]any{
+		"orchestration_id": "E1A2B3C",
+		"route_to":         "PSVC1",
+		"conditions":       `[{"expression":"event.source == 'database'"}]`,
+	}
+
+	result, err := appendEventOrchestrationRouterRuleHandler(c)(context.Background(), newToolRequest(args))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "invalid condition 1") {
+		t.Errorf("Expected an invalid condition error, got %v", result.Content)
+	}
+	if calls != 0 {
+		t.Errorf("Expected no API calls, got %d", calls)
+	}
+
+	args["skip_condition_check"] = true
+	result, err = appendEventOrchestrationRouterRuleHandler(c)(context.Background(), newToolRequest(args))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Errorf("Expected success with skip_condition_check, got %v", result.Content)
+	}
+}
+
+// TestListEventOrchestrationIntegrations tests that integrations and their routing keys are listed
+func TestListEventOrchestrationIntegrations(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/event_orchestrations/E1A2B3C/integrations" {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"integrations":[{"id":"I1","label":"Default","parameters":{"routing_key":"R0KEY1","type":"global"}}]}`))
+	})
+
+	result, err := listEventOrchestrationIntegrationsHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"orchestration_id": "E1A2B3C",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out models.ListResponse[models.EventOrchestrationIntegration]
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Response) != 1 || out.Response[0].Parameters == nil || out.Response[0].Parameters.RoutingKey != "R0KEY1" {
+		t.Errorf("Expected integration I1 with its routing key, got %+v", out.Response)
+	}
+}
+
+// TestRotateEventOrchestrationIntegrationKey tests that the replacement is
+// created with the old label before the old integration is deleted, and that a
+// failed delete names both integrations
+func TestRotateEventOrchestrationIntegrationKey(t *testing.T) {
+	var calls []string
+	var create models.EventOrchestrationIntegrationRequest
+	deleteStatus := http.StatusNoContent
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"integration":{"id":"IOLD","label":"Datadog"}}`))
+		case http.MethodPost:
+			if err := json.NewDecoder(r.Body).Decode(&create); err != nil {
+				t.Errorf("Failed to parse create body: %v", err)
+			}
+			w.Write([]byte(`{"integration":{"id":"INEW","label":"Datadog","parameters":{"routing_key":"R0NEWKEY"}}}`))
+		case http.MethodDelete:
+			w.WriteHeader(deleteStatus)
+		}
+	})
+	args := map[string]any{"orchestration_id": "E1A2B3C", "integration_id": "IOLD"}
+
+	result, err := rotateEventOrchestrationIntegrationKeyHandler(c)(context.Background(), newToolRequest(args))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	want := []string{
+		"GET /event_orchestrations/E1A2B3C/integrations/IOLD",
+		"POST /event_orchestrations/E1A2B3C/integrations",
+		"DELETE /event_orchestrations/E1A2B3C/integrations/IOLD",
+	}
+	if strings.Join(calls, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected calls %v, got %v", want, calls)
+	}
+	if create.Integration.Label != "Datadog" {
+		t.Errorf("Expected the label to be carried over, got '%s'", create.Integration.Label)
+	}
+
+	var out models.EventOrchestrationIntegrationRotation
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if out.Integration.ID != "INEW" || out.RevokedIntegrationID != "IOLD" {
+		t.Errorf("Expected INEW to replace IOLD, got %+v", out)
+	}
+
+	deleteStatus = http.StatusInternalServerError
+	result, err = rotateEventOrchestrationIntegrationKeyHandler(c)(context.Background(), newToolRequest(args))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Fatal("Expected a failed delete to be reported")
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.Contains(text, "INEW") || !strings.Contains(text, "IOLD") {
+		t.Errorf("Expected the error to name both integrations, got %s", text)
+	}
+}
@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RegisterEventWriteTools registers tools that send events to the Events API
+func RegisterEventWriteTools(s *server.MCPServer, c *client.Client) {
+	// send_event
+	s.AddTool(mcp.NewTool("send_event",
+		mcp.WithDescription("Send an alert event to a service or event orchestration integration through the Events API. A trigger event opens or adds to an alert; acknowledge and resolve events act on the alert with the given dedup_key. Authenticated by the integration's routing key, not the API token."),
+		mcp.WithTitleAnnotation("Send Alert Event"),
+		mcp.WithString("routing_key", mcp.Required(), mcp.Description("The 32 character integration (routing) key")),
+		mcp.WithString("event_action", mcp.Required(), mcp.Description("What the event does to the alert"), mcp.Enum("trigger", "acknowledge", "resolve")),
+		mcp.WithString("dedup_key", mcp.Description("Identifies the alert. Required for acknowledge and resolve; for trigger, events with the same key update one alert")),
+		mcp.WithString("summary", mcp.Description("Brief description of the problem (required for trigger)")),
+		mcp.WithString("source", mcp.Description("The affected system, such as a hostname (required for trigger)")),
+		mcp.WithString("severity", mcp.Description("Severity of the problem (required for trigger)"), mcp.Enum("critical", "error", "warning", "info")),
+		mcp.WithString("component", mcp.Description("The part of the source that is affected (e.g., 'mysql')")),
+		mcp.WithString("group", mcp.Description("Logical grouping of components (e.g., 'prod-datapipe')")),
+		mcp.WithString("class", mcp.Description("The class or type of the event (e.g., 'disk usage')")),
+		mcp.WithString("custom_details", mcp.Description(`Additional details as a JSON object (e.g., '{"free_space":"1%"}')`)),
+	), sendEventHandler(c))
+
+	// send_change_event
+	s.AddTool(mcp.NewTool("send_change_event",
+		mcp.WithDescription("Send a change event, such as a deploy or configuration change, to a service integration through the Events API. Change events show up next to incidents to help find what caused them. Authenticated by the integration's routing key, not the API token."),
+		mcp.WithTitleAnnotation("Send Change Event"),
+		mcp.WithString("routing_key", mcp.Required(), mcp.Description("The 32 character integration (routing) key")),
+		mcp.WithString("summary", mcp.Required(), mcp.Description("Brief description of the change (e.g., 'Deployed payments v1.4.2')")),
+		mcp.WithString("source", mcp.Description("The system that made the change (e.g., 'github-actions')")),
+		mcp.WithString("timestamp", mcp.Description("When the change happened, ISO 8601 (default: when PagerDuty receives the event)")),
+		mcp.WithString("custom_details", mcp.Description(`Additional details as a JSON object (e.g., '{"commit":"abc123"}')`)),
+	), sendChangeEventHandler(c))
+}
+
+// getCustomDetails parses the optional custom_details JSON object argument
+func getCustomDetails(args map[string]any) (map[string]any, error) {
+	v, ok := getString(args, "custom_details")
+	if !ok {
+		return nil, nil
+	}
+	var details map[string]any
+	if err := json.Unmarshal([]byte(v), &details); err != nil {
+		return nil, fmt.Errorf("invalid custom_details JSON: expected an object: %v", err)
+	}
+	return details, nil
+}
+
+func sendEventHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		routingKey, ok := getString(args, "routing_key")
+		if !ok {
+			return mcp.NewToolResultError("routing_key is required"), nil
+		}
+		action, ok := getString(args, "event_action")
+		if !ok {
+			return mcp.NewToolResultError("event_action is required"), nil
+		}
+
+		event := client.AlertEvent{RoutingKey: routingKey, EventAction: action}
+		event.DedupKey, _ = getString(args, "dedup_key")
+
+		// Only trigger events carry a payload
+		if action == "trigger" {
+			details, err := getCustomDetails(args)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			payload := &client.AlertPayload{CustomDetails: details}
+			payload.Summary, _ = getString(args, "summary")
+			payload.Source, _ = getString(args, "source")
+			payload.Severity, _ = getString(args, "severity")
+			payload.Component, _ = getString(args, "component")
+			payload.Group, _ = getString(args, "group")
+			payload.Class, _ = getString(args, "class")
+			event.Payload = payload
+		}
+
+		resp, err := c.Events().EnqueueAlert(ctx, event)
+		if err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func sendChangeEventHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		routingKey, ok := getString(args, "routing_key")
+		if !ok {
+			return mcp.NewToolResultError("routing_key is required"), nil
+		}
+		summary, ok := getString(args, "summary")
+		if !ok {
+			return mcp.NewToolResultError("summary is required"), nil
+		}
+
+		timestamp, _, err := getDateTime(args, "timestamp")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		details, err := getCustomDetails(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		event := client.ChangeEvent{
+			RoutingKey: routingKey,
+			Payload: client.ChangePayload{
+				Summary:       summary,
+				Timestamp:     timestamp,
+				CustomDetails: details,
+			},
+		}
+		event.Payload.Source, _ = getString(args, "source")
+
+		resp, err := c.Events().EnqueueChange(ctx, event)
+		if err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
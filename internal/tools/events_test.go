@@ -0,0 +1,100 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+)
+
+// newTestEventsToolClient creates a client whose Events API host is a test server
+func newTestEventsToolClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return client.NewClient(client.Config{APIKey: "test-api-key", EventsHost: ts.URL})
+}
+
+// TestSendEvent_Trigger tests that a trigger event is sent with its payload and custom details
+func TestSendEvent_Trigger(t *testing.T) {
+	var event client.AlertEvent
+	c := newTestEventsToolClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/enqueue" {
+			t.Errorf("Expected /v2/enqueue, got %s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Errorf("Failed to parse event: %v", err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status":"success","message":"Event processed","dedup_key":"abc123"}`))
+	})
+
+	result, err := sendEventHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"routing_key":    "R0UT1NGKEY",
+		"event_action":   "trigger",
+		"summary":        "Disk full on db1",
+		"source":         "db1",
+		"severity":       "critical",
+		"custom_details": `{"free_space":"1%"}`,
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if event.RoutingKey != "R0UT1NGKEY" || event.Payload == nil || event.Payload.Source != "db1" || event.Payload.CustomDetails["free_space"] != "1%" {
+		t.Errorf("Unexpected event %+v", event)
+	}
+}
+
+// TestSendEvent_ResolveRequiresDedupKey tests that resolve events without a dedup key are rejected before sending
+func TestSendEvent_ResolveRequiresDedupKey(t *testing.T) {
+	c := newTestEventsToolClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no API call, got %s %s", r.Method, r.URL.Path)
+	})
+
+	result, _ := sendEventHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"routing_key":  "R0UT1NGKEY",
+		"event_action": "resolve",
+	}))
+	if !result.IsError {
+		t.Error("Expected an error result without dedup_key")
+	}
+}
+
+// TestSendChangeEvent tests that change events are sent to the change endpoint
+func TestSendChangeEvent(t *testing.T) {
+	var event client.ChangeEvent
+	c := newTestEventsToolClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/change/enqueue" {
+			t.Errorf("Expected /v2/change/enqueue, got %s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Errorf("Failed to parse event: %v", err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status":"success","message":"Change event processed"}`))
+	})
+
+	result, err := sendChangeEventHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"routing_key": "R0UT1NGKEY",
+		"summary":     "Deployed payments v1.4.2",
+		"timestamp":   "2024-01-15T10:00:00Z",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if event.Payload.Summary != "Deployed payments v1.4.2" || event.Payload.Timestamp != "2024-01-15T10:00:00Z" {
+		t.Errorf("Unexpected event %+v", event)
+	}
+}
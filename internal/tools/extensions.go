@@ -0,0 +1,278 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RegisterExtensionReadTools registers read-only extension tools
+func RegisterExtensionReadTools(s *server.MCPServer, c *client.Client) {
+	// list_extensions
+	s.AddTool(mcp.NewTool("list_extensions",
+		mcp.WithDescription("List extensions (outbound integrations) that push incident events from services to external systems such as Slack, ServiceNow, or generic webhooks."),
+		mcp.WithTitleAnnotation("List Extensions"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("query", mcp.Description("Filter extensions by name (partial match supported)")),
+		mcp.WithString("service_id", mcp.Description("Only show extensions attached to this service (e.g., 'PSERVICE123')")),
+		mcp.WithString("extension_schema_id", mcp.Description("Only show extensions of this type. Use list_extension_schemas to find schema IDs.")),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+	), listExtensionsHandler(c))
+
+	// get_extension
+	s.AddTool(mcp.NewTool("get_extension",
+		mcp.WithDescription("Get details of an extension, including its endpoint URL, schema, configuration, and the services it is attached to."),
+		mcp.WithTitleAnnotation("Get Extension"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("extension_id", mcp.Required(), mcp.Description("The unique extension ID")),
+	), getExtensionHandler(c))
+
+	// list_extension_schemas
+	s.AddTool(mcp.NewTool("list_extension_schemas",
+		mcp.WithDescription("List the available extension types (schemas) and what they send. Use to find the extension_schema_id needed to create an extension."),
+		mcp.WithTitleAnnotation("List Extension Schemas"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+	), listExtensionSchemasHandler(c))
+}
+
+// RegisterExtensionWriteTools registers write extension tools
+func RegisterExtensionWriteTools(s *server.MCPServer, c *client.Client) {
+	// create_extension
+	s.AddTool(mcp.NewTool("create_extension",
+		mcp.WithDescription("Create an extension that sends incident events from services to an external system. Use list_extension_schemas to choose the extension type."),
+		mcp.WithTitleAnnotation("Create Extension"),
+		mcp.WithString("name", mcp.Required(), mcp.Description("A descriptive name for the extension (e.g., 'Ops Slack Webhook')")),
+		mcp.WithString("extension_schema_id", mcp.Required(), mcp.Description("The extension type's schema ID")),
+		mcp.WithString("service_ids", mcp.Required(), mcp.Description("Services to attach the extension to. Comma-separated service IDs (e.g., 'PSVC1,PSVC2')")),
+		mcp.WithString("endpoint_url", mcp.Description("The http(s) URL events are sent to, for extension types that need one")),
+		mcp.WithString("config", mcp.Description(`Extension type specific configuration as a JSON object (e.g., '{"notify_types":{"resolve":true}}')`)),
+	), createExtensionHandler(c))
+
+	// update_extension
+	s.AddTool(mcp.NewTool("update_extension",
+		mcp.WithDescription("Update an extension's name, endpoint URL, attached services, or configuration. Only the provided fields are changed."),
+		mcp.WithTitleAnnotation("Update Extension"),
+		mcp.WithString("extension_id", mcp.Required(), mcp.Description("The unique extension ID to update")),
+		mcp.WithString("name", mcp.Description("New extension name")),
+		mcp.WithString("endpoint_url", mcp.Description("New http(s) endpoint URL")),
+		mcp.WithString("service_ids", mcp.Description("Replace the attached services. Comma-separated service IDs")),
+		mcp.WithString("config", mcp.Description("Replace the configuration with this JSON object")),
+	), updateExtensionHandler(c))
+
+	// delete_extension
+	s.AddTool(mcp.NewTool("delete_extension",
+		mcp.WithDescription("WARNING: DESTRUCTIVE - Permanently delete an extension. Its services will stop sending incident events to the external system."),
+		mcp.WithTitleAnnotation("Delete Extension"),
+		mcp.WithDestructiveHintAnnotation(true),
+		mcp.WithString("extension_id", mcp.Required(), mcp.Description("The unique extension ID to delete")),
+	), deleteExtensionHandler(c))
+}
+
+func listExtensionsHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		var query models.ExtensionQuery
+
+		if v, ok := getString(args, "query"); ok {
+			query.Query = v
+		}
+		if v, ok := getString(args, "service_id"); ok {
+			query.ExtensionObjectID = v
+		}
+		if v, ok := getString(args, "extension_schema_id"); ok {
+			query.ExtensionSchemaID = v
+		}
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Limit = v
+		}
+
+		var resp models.ExtensionsResponse
+		if err := c.GetJSONWithContext(ctx, "/extensions", query.ToParams(), &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		result := models.ListResponse[models.Extension]{Response: resp.Extensions}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
+	}
+}
+
+func getExtensionHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		extensionID, ok := getString(args, "extension_id")
+		if !ok {
+			return mcp.NewToolResultError("extension_id is required"), nil
+		}
+
+		var resp models.ExtensionResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/extensions/%s", extensionID), nil, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.Extension)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func listExtensionSchemasHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		params := make(map[string]string)
+
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
+		}
+
+		var resp models.ExtensionSchemasResponse
+		if err := c.GetJSONWithContext(ctx, "/extension_schemas", params, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		result := models.ListResponse[models.ExtensionSchema]{Response: resp.ExtensionSchemas}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
+	}
+}
+
+// getExtensionData reads the optional name, endpoint_url, service_ids, and config arguments
+func getExtensionData(args map[string]any) (models.ExtensionData, error) {
+	extension := models.ExtensionData{Type: "extension"}
+
+	if v, ok := getString(args, "name"); ok {
+		extension.Name = v
+	}
+	if v, ok := getString(args, "endpoint_url"); ok {
+		if !isHTTPURL(v) {
+			return extension, fmt.Errorf("invalid endpoint_url format: expected an http(s) URL")
+		}
+		extension.EndpointURL = v
+	}
+	if v, ok := getStringArray(args, "service_ids"); ok {
+		for _, id := range v {
+			extension.ExtensionObjects = append(extension.ExtensionObjects, models.ServiceReference{
+				ID:   id,
+				Type: "service_reference",
+			})
+		}
+	}
+	if v, ok := getString(args, "config"); ok {
+		if err := json.Unmarshal([]byte(v), &extension.Config); err != nil {
+			return extension, fmt.Errorf("invalid config JSON: expected an object: %v", err)
+		}
+	}
+	return extension, nil
+}
+
+func createExtensionHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		if _, ok := getString(args, "name"); !ok {
+			return mcp.NewToolResultError("name is required"), nil
+		}
+
+		schemaID, ok := getString(args, "extension_schema_id")
+		if !ok {
+			return mcp.NewToolResultError("extension_schema_id is required"), nil
+		}
+
+		if _, ok := getStringArray(args, "service_ids"); !ok {
+			return mcp.NewToolResultError("service_ids is required"), nil
+		}
+
+		extension, err := getExtensionData(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		extension.ExtensionSchema = &models.ExtensionSchemaReference{
+			ID:   schemaID,
+			Type: "extension_schema_reference",
+		}
+
+		req := models.ExtensionCreateRequest{Extension: extension}
+
+		var resp models.ExtensionResponse
+		if err := c.PostJSONWithContext(ctx, "/extensions", req, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.Extension)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func updateExtensionHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		extensionID, ok := getString(args, "extension_id")
+		if !ok {
+			return mcp.NewToolResultError("extension_id is required"), nil
+		}
+
+		extension, err := getExtensionData(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if extension.Name == "" && extension.EndpointURL == "" && extension.ExtensionObjects == nil && extension.Config == nil {
+			return mcp.NewToolResultError("at least one of name, endpoint_url, service_ids, or config is required"), nil
+		}
+
+		// The API replaces the whole extension, so fill unchanged fields from the current one
+		var current models.ExtensionResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/extensions/%s", extensionID), nil, &current); err != nil {
+			return toolError(fmt.Errorf("failed to get extension: %w", err)), nil
+		}
+		if extension.Name == "" {
+			extension.Name = current.Extension.Name
+		}
+		if extension.EndpointURL == "" {
+			extension.EndpointURL = current.Extension.EndpointURL
+		}
+		if extension.ExtensionObjects == nil {
+			for _, obj := range current.Extension.ExtensionObjects {
+				extension.ExtensionObjects = append(extension.ExtensionObjects, models.ServiceReference{ID: obj.ID, Type: obj.Type})
+			}
+		}
+		if extension.Config == nil {
+			extension.Config = current.Extension.Config
+		}
+		extension.ExtensionSchema = &models.ExtensionSchemaReference{
+			ID:   current.Extension.ExtensionSchema.ID,
+			Type: "extension_schema_reference",
+		}
+
+		req := models.ExtensionCreateRequest{Extension: extension}
+
+		var resp models.ExtensionResponse
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/extensions/%s", extensionID), req, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.Extension)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func deleteExtensionHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		extensionID, ok := getString(args, "extension_id")
+		if !ok {
+			return mcp.NewToolResultError("extension_id is required"), nil
+		}
+
+		if _, err := c.DeleteWithContext(ctx, fmt.Sprintf("/extensions/%s", extensionID)); err != nil {
+			return toolError(err), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Extension %s deleted successfully", extensionID)), nil
+	}
+}
@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// TestCreateExtension tests that the schema, services, and config are sent in the create request
+func TestCreateExtension(t *testing.T) {
+	var req models.ExtensionCreateRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/extensions" {
+			t.Errorf("Expected POST /extensions, got %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Errorf("Failed to parse request body: %v", err)
+		}
+		w.Write([]byte(`{"extension":{"id":"PEXT1","name":"Ops Webhook"}}`))
+	})
+
+	result, err := createExtensionHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"name":                "Ops Webhook",
+		"extension_schema_id": "PSCHEMA1",
+		"service_ids":         "PSVC1, PSVC2",
+		"endpoint_url":        "https://hooks.example.com/pagerduty",
+		"config":              `{"notify_types":{"resolve":true}}`,
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	ext := req.Extension
+	if ext.ExtensionSchema == nil || ext.ExtensionSchema.ID != "PSCHEMA1" || ext.ExtensionSchema.Type != "extension_schema_reference" {
+		t.Errorf("Unexpected extension_schema %+v", ext.ExtensionSchema)
+	}
+	if len(ext.ExtensionObjects) != 2 || ext.ExtensionObjects[1].ID != "PSVC2" {
+		t.Errorf("Expected two service references, got %+v", ext.ExtensionObjects)
+	}
+	if _, ok := ext.Config["notify_types"]; !ok {
+		t.Errorf("Expected config to be sent, got %+v", ext.Config)
+	}
+}
+
+// TestUpdateExtension tests that supplied fields are overlaid on the current
+// extension and the full object is PUT
+func TestUpdateExtension(t *testing.T) {
+	var req models.ExtensionCreateRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/extensions/PEXT1" {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"extension":{"id":"PEXT1","name":"Ops Webhook","endpoint_url":"https://hooks.example.com/old",
+				"extension_schema":{"id":"PSCHEMA1","type":"extension_schema_reference","summary":"Generic V2 Webhook"},
+				"extension_objects":[{"id":"PSVC1","type":"service_reference","summary":"API"}],
+				"config":{"notify_types":{"resolve":true}}}}`))
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &req); err != nil {
+				t.Errorf("Failed to parse request body: %v", err)
+			}
+			w.Write([]byte(`{"extension":{"id":"PEXT1"}}`))
+		}
+	})
+
+	result, err := updateExtensionHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"extension_id": "PEXT1",
+		"name":         "Ops Webhook v2",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	ext := req.Extension
+	if ext.Name != "Ops Webhook v2" || ext.EndpointURL != "https://hooks.example.com/old" {
+		t.Errorf("Expected the new name and the current endpoint, got %+v", ext)
+	}
+	if ext.ExtensionSchema == nil || ext.ExtensionSchema.ID != "PSCHEMA1" {
+		t.Errorf("Expected the current extension_schema, got %+v", ext.ExtensionSchema)
+	}
+	if len(ext.ExtensionObjects) != 1 || ext.ExtensionObjects[0].ID != "PSVC1" || ext.ExtensionObjects[0].Type != "service_reference" {
+		t.Errorf("Expected the current services, got %+v", ext.ExtensionObjects)
+	}
+	if _, ok := ext.Config["notify_types"]; !ok {
+		t.Errorf("Expected the current config, got %+v", ext.Config)
+	}
+}
+
+// TestExtension_Validation tests that bad endpoint URLs, config, and empty updates are rejected before calling the API
+func TestExtension_Validation(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no API call, got %s %s", r.Method, r.URL.Path)
+	})
+
+	create := map[string]any{"name": "Hook", "extension_schema_id": "PSCHEMA1", "service_ids": "PSVC1"}
+	tests := []struct {
+		name    string
+		handler func(*client.Client) server.ToolHandlerFunc
+		args    map[string]any
+	}{
+		{"bad endpoint_url", createExtensionHandler, withArgs(create, "endpoint_url", "ftp://example.com")},
+		{"bad config", createExtensionHandler, withArgs(create, "config", `["not","an","object"]`)},
+		{"empty update", updateExtensionHandler, map[string]any{"extension_id": "PEXT1"}},
+	}
+	for _, tt := range tests {
+		result, _ := tt.handler(c)(context.Background(), newToolRequest(tt.args))
+		if !result.IsError {
+			t.Errorf("%s: expected an error result", tt.name)
+		}
+	}
+}
+
+// withArgs returns a copy of args with key set to value
+func withArgs(args map[string]any, key string, value any) map[string]any {
+	out := make(map[string]any, len(args)+1)
+	for k, v := range args {
+		out[k] = v
+	}
+	out[key] = value
+	return out
+}
@@ -0,0 +1,154 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// statusLogEntryTypes maps the log entry types that change an incident's
+// status to the status they move it into. Snoozes and reassignments keep the
+// current status and are ignored.
+var statusLogEntryTypes = map[string]string{
+	"trigger_log_entry":       "triggered",
+	"acknowledge_log_entry":   "acknowledged",
+	"unacknowledge_log_entry": "triggered",
+	"resolve_log_entry":       "resolved",
+}
+
+func getIncidentDurationHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+
+		var incidentResp models.IncidentResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s", incidentID), nil, &incidentResp); err != nil {
+			return toolError(err), nil
+		}
+
+		// Status changes are part of the overview log
+		var entries []models.LogEntry
+		params := map[string]string{"is_overview": "true"}
+		err := c.PaginateWithContext(ctx, fmt.Sprintf("/incidents/%s/log_entries", incidentID), params, models.MaxResults, func(data []byte) (int, error) {
+			var page models.LogEntriesResponse
+			if err := json.Unmarshal(data, &page); err != nil {
+				return 0, err
+			}
+			entries = append(entries, page.LogEntries...)
+			return len(page.LogEntries), nil
+		})
+		if err != nil {
+			return toolError(err), nil
+		}
+
+		duration := incidentDuration(incidentResp.Incident, entries, time.Now().UTC())
+		duration.LogTruncated = len(entries) >= models.MaxResults
+
+		data, _ := json.Marshal(duration)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+// incidentDuration replays the incident's status changes in time order and
+// totals the time spent triggered and acknowledged. Open incidents are
+// measured up to now.
+func incidentDuration(incident models.Incident, entries []models.LogEntry, now time.Time) models.IncidentDuration {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt.Time)
+	})
+
+	result := models.IncidentDuration{
+		Incident: models.IncidentReference{
+			ID:      incident.ID,
+			Summary: referenceLabel(incident.Title, incident.Summary),
+			HTMLURL: incident.HTMLURL,
+		},
+		Status:    incident.Status,
+		CreatedAt: incident.CreatedAt,
+	}
+
+	// origin is where the replay starts, so the total always covers every status span
+	start := incident.CreatedAt.Time
+	origin := start
+	status := "triggered"
+	spent := make(map[string]time.Duration)
+	for _, entry := range entries {
+		next, ok := statusLogEntryTypes[entry.Type]
+		if !ok || entry.CreatedAt.IsZero() {
+			continue
+		}
+		at := entry.CreatedAt.Time
+		if start.IsZero() || at.Before(start) {
+			// The trigger entry can predate created_at by a moment; never count backwards
+			start, origin = at, at
+			if result.CreatedAt.IsZero() {
+				result.CreatedAt = entry.CreatedAt
+			}
+		}
+		if status != "resolved" {
+			spent[status] += at.Sub(start)
+		}
+		if next != status {
+			result.Transitions++
+		}
+		if next == "acknowledged" && result.FirstAcknowledgedAt.IsZero() {
+			result.FirstAcknowledgedAt = entry.CreatedAt
+		}
+		if next == "resolved" {
+			result.ResolvedAt = entry.CreatedAt
+		}
+		status, start = next, at
+	}
+
+	// A resolved incident whose resolve entry was not read (a truncated log)
+	// ends at its last status change rather than being measured as open
+	if status != "resolved" && incident.Status == "resolved" && !incident.LastStatusChangeAt.IsZero() {
+		at := incident.LastStatusChangeAt.Time
+		if !start.IsZero() && at.After(start) {
+			spent[status] += at.Sub(start)
+		}
+		result.ResolvedAt = incident.LastStatusChangeAt
+		status = "resolved"
+	}
+
+	end := now
+	if status == "resolved" {
+		end = result.ResolvedAt.Time
+	} else {
+		result.ResolvedAt = models.PDTime{}
+		if !start.IsZero() && end.After(start) {
+			spent[status] += end.Sub(start)
+		}
+	}
+	result.MeasuredUntil = models.PDTime{Time: end}
+
+	result.TriggeredSeconds = int64(spent["triggered"].Seconds())
+	result.AcknowledgedSeconds = int64(spent["acknowledged"].Seconds())
+	result.TotalSeconds = int64(end.Sub(origin).Seconds())
+	if !result.FirstAcknowledgedAt.IsZero() {
+		result.TimeToAcknowledgeSeconds = int64(result.FirstAcknowledgedAt.Sub(origin).Seconds())
+	}
+	if !result.ResolvedAt.IsZero() {
+		result.TimeToResolveSeconds = result.TotalSeconds
+	}
+
+	total := time.Duration(result.TotalSeconds) * time.Second
+	triggered := time.Duration(result.TriggeredSeconds) * time.Second
+	acknowledged := time.Duration(result.AcknowledgedSeconds) * time.Second
+	if result.ResolvedAt.IsZero() {
+		result.Summary = fmt.Sprintf("Open for %s so far: %s triggered, %s acknowledged", total, triggered, acknowledged)
+	} else {
+		result.Summary = fmt.Sprintf("Resolved after %s: %s triggered, %s acknowledged", total, triggered, acknowledged)
+	}
+	return result
+}
@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestGetIncidentDuration tests that time in each status is totalled across
+// an acknowledgement timing out back to triggered before resolution
+func TestGetIncidentDuration(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents/PINC1":
+			w.Write([]byte(`{"incident":{"id":"PINC1","title":"DB down","status":"resolved","created_at":"2024-01-15T10:00:00Z"}}`))
+		case "/incidents/PINC1/log_entries":
+			if r.URL.Query().Get("is_overview") != "true" {
+				t.Errorf("Expected is_overview=true, got %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"log_entries":[
+				{"id":"L5","type":"resolve_log_entry","created_at":"2024-01-15T11:00:00Z"},
+				{"id":"L1","type":"trigger_log_entry","created_at":"2024-01-15T10:00:00Z"},
+				{"id":"L2","type":"acknowledge_log_entry","created_at":"2024-01-15T10:05:00Z"},
+				{"id":"L3","type":"unacknowledge_log_entry","created_at":"2024-01-15T10:35:00Z"},
+				{"id":"L4","type":"acknowledge_log_entry","created_at":"2024-01-15T10:40:00Z"},
+				{"id":"LX","type":"notify_log_entry","created_at":"2024-01-15T10:01:00Z"}
+			],"more":false}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	result, err := getIncidentDurationHandler(c)(context.Background(), newToolRequest(map[string]any{"incident_id": "PINC1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out models.IncidentDuration
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if out.TriggeredSeconds != 600 || out.AcknowledgedSeconds != 3000 {
+		t.Errorf("Expected 600s triggered and 3000s acknowledged, got %d and %d", out.TriggeredSeconds, out.AcknowledgedSeconds)
+	}
+	if out.TotalSeconds != 3600 || out.TimeToResolveSeconds != 3600 || out.TimeToAcknowledgeSeconds != 300 {
+		t.Errorf("Unexpected totals: %+v", out)
+	}
+	if out.Transitions != 4 || out.Incident.Summary != "DB down" {
+		t.Errorf("Unexpected result: %+v", out)
+	}
+}
+
+// TestIncidentDuration_Open tests that an open incident is measured up to now
+func TestIncidentDuration_Open(t *testing.T) {
+	created, _ := models.ParsePDTime("2024-01-15T10:00:00Z")
+	acked, _ := models.ParsePDTime("2024-01-15T10:10:00Z")
+	now := created.Add(30 * time.Minute)
+
+	out := incidentDuration(models.Incident{ID: "PINC1", Status: "acknowledged", CreatedAt: created}, []models.LogEntry{
+		{Type: "acknowledge_log_entry", CreatedAt: acked},
+	}, now)
+
+	if !out.ResolvedAt.IsZero() || out.TimeToResolveSeconds != 0 {
+		t.Errorf("Expected no resolution, got %+v", out)
+	}
+	if out.TriggeredSeconds != 600 || out.AcknowledgedSeconds != 1200 || out.TotalSeconds != 1800 {
+		t.Errorf("Unexpected breakdown: %+v", out)
+	}
+	if !out.MeasuredUntil.Equal(now) {
+		t.Errorf("Expected measured until %v, got %v", now, out.MeasuredUntil)
+	}
+}
+
+// TestIncidentDuration_ResolvedWithoutResolveEntry tests that a resolved
+// incident whose resolve entry was not read ends at its last status change
+func TestIncidentDuration_ResolvedWithoutResolveEntry(t *testing.T) {
+	created, _ := models.ParsePDTime("2024-01-15T10:00:00Z")
+	acked, _ := models.ParsePDTime("2024-01-15T10:10:00Z")
+	resolved, _ := models.ParsePDTime("2024-01-15T10:40:00Z")
+	now := created.Add(24 * time.Hour)
+
+	out := incidentDuration(models.Incident{ID: "PINC1", Status: "resolved", CreatedAt: created, LastStatusChangeAt: resolved}, []models.LogEntry{
+		{Type: "acknowledge_log_entry", CreatedAt: acked},
+	}, now)
+
+	if !out.ResolvedAt.Equal(resolved.Time) || !out.MeasuredUntil.Equal(resolved.Time) {
+		t.Errorf("Expected resolution at %v, got %+v", resolved, out)
+	}
+	if out.TriggeredSeconds != 600 || out.AcknowledgedSeconds != 1800 || out.TotalSeconds != 2400 || out.TimeToResolveSeconds != 2400 {
+		t.Errorf("Unexpected breakdown: %+v", out)
+	}
+	if !strings.HasPrefix(out.Summary, "Resolved after") {
+		t.Errorf("Expected a resolved summary, got %q", out.Summary)
+	}
+}
+
+// TestIncidentDuration_TriggerBeforeCreatedAt tests that the total covers
+// time spent triggered before created_at
+func TestIncidentDuration_TriggerBeforeCreatedAt(t *testing.T) {
+	created, _ := models.ParsePDTime("2024-01-15T10:00:05Z")
+	triggered, _ := models.ParsePDTime("2024-01-15T10:00:00Z")
+	acked, _ := models.ParsePDTime("2024-01-15T10:10:00Z")
+	resolved, _ := models.ParsePDTime("2024-01-15T10:20:00Z")
+
+	out := incidentDuration(models.Incident{ID: "PINC1", Status: "resolved", CreatedAt: created}, []models.LogEntry{
+		{Type: "trigger_log_entry", CreatedAt: triggered},
+		{Type: "acknowledge_log_entry", CreatedAt: acked},
+		{Type: "resolve_log_entry", CreatedAt: resolved},
+	}, resolved.Add(time.Hour))
+
+	if out.TriggeredSeconds != 600 || out.AcknowledgedSeconds != 600 {
+		t.Errorf("Expected 600s triggered and 600s acknowledged, got %d and %d", out.TriggeredSeconds, out.AcknowledgedSeconds)
+	}
+	if out.TotalSeconds != out.TriggeredSeconds+out.AcknowledgedSeconds || out.TimeToAcknowledgeSeconds != 600 {
+		t.Errorf("Expected total to equal its parts, got %+v", out)
+	}
+}
@@ -0,0 +1,202 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxReportLogEntries bounds the timeline in export_incident_report. A single
+// page keeps the report to a predictable size and API cost.
+const maxReportLogEntries = 100
+
+// maxReportChangeEvents bounds the related change events in export_incident_report
+const maxReportChangeEvents = 25
+
+func exportIncidentReportHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+
+		format := "json"
+		if v, ok := getString(args, "format"); ok {
+			format = v
+		}
+		if format != "json" && format != "markdown" {
+			return mcp.NewToolResultError("format must be 'json' or 'markdown'"), nil
+		}
+
+		// The incident itself is required; the other sections are best effort
+		var incidentResp models.IncidentResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s", incidentID), nil, &incidentResp); err != nil {
+			return toolError(err), nil
+		}
+		report := models.IncidentReport{
+			Incident:      incidentResp.Incident,
+			Timeline:      []models.LogEntry{},
+			Notes:         []models.IncidentNote{},
+			ChangeEvents:  []models.ChangeEvent{},
+			StatusUpdates: []models.IncidentStatusUpdate{},
+			GeneratedAt:   models.PDTime{Time: time.Now().UTC()},
+		}
+
+		sections := []struct {
+			name  string
+			fetch func(ctx context.Context) error
+		}{
+			{"timeline", func(ctx context.Context) error {
+				var resp models.LogEntriesResponse
+				params := map[string]string{"is_overview": "true", "limit": fmt.Sprintf("%d", maxReportLogEntries)}
+				if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/log_entries", incidentID), params, &resp); err != nil {
+					return err
+				}
+				report.Timeline = append(report.Timeline, resp.LogEntries...)
+				report.TimelineTruncated = resp.More
+				return nil
+			}},
+			{"notes", func(ctx context.Context) error {
+				var resp models.IncidentNotesResponse
+				if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/notes", incidentID), nil, &resp); err != nil {
+					return err
+				}
+				report.Notes = append(report.Notes, resp.Notes...)
+				return nil
+			}},
+			{"change_events", func(ctx context.Context) error {
+				var resp models.ChangeEventsResponse
+				params := map[string]string{"limit": fmt.Sprintf("%d", maxReportChangeEvents)}
+				if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/related_change_events", incidentID), params, &resp); err != nil {
+					return err
+				}
+				report.ChangeEvents = append(report.ChangeEvents, resp.ChangeEvents...)
+				return nil
+			}},
+			{"status_updates", func(ctx context.Context) error {
+				var resp models.IncidentStatusUpdatesResponse
+				if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/status_updates", incidentID), nil, &resp); err != nil {
+					return err
+				}
+				report.StatusUpdates = append(report.StatusUpdates, resp.StatusUpdates...)
+				return nil
+			}},
+		}
+		errs := forEachConcurrent(ctx, len(sections), maxConcurrentRequests, func(ctx context.Context, i int) error {
+			return sections[i].fetch(ctx)
+		})
+		for i, err := range errs {
+			if err != nil {
+				report.Warnings = append(report.Warnings, fmt.Sprintf("%s unavailable: %v", sections[i].name, err))
+			}
+		}
+
+		// Reviews read the timeline in the order things happened
+		sort.SliceStable(report.Timeline, func(i, j int) bool {
+			return report.Timeline[i].CreatedAt.Before(report.Timeline[j].CreatedAt.Time)
+		})
+		sort.SliceStable(report.ChangeEvents, func(i, j int) bool {
+			return report.ChangeEvents[i].Timestamp.Before(report.ChangeEvents[j].Timestamp.Time)
+		})
+
+		if format == "markdown" {
+			return mcp.NewToolResultText(incidentReportMarkdown(report)), nil
+		}
+		data, err := json.Marshal(report)
+		if err != nil {
+			return toolError(err), nil
+		}
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+// incidentReportMarkdown renders the report as a markdown document
+func incidentReportMarkdown(report models.IncidentReport) string {
+	incident := report.Incident
+	var sb strings.Builder
+
+	title := incident.Title
+	if title == "" {
+		title = incident.Summary
+	}
+	fmt.Fprintf(&sb, "# Incident #%d: %s\n\n", incident.IncidentNumber, title)
+	fmt.Fprintf(&sb, "- **ID:** %s\n", incident.ID)
+	fmt.Fprintf(&sb, "- **Status:** %s\n", incident.Status)
+	if incident.Urgency != "" {
+		fmt.Fprintf(&sb, "- **Urgency:** %s\n", incident.Urgency)
+	}
+	if incident.Priority != nil && incident.Priority.Summary != "" {
+		fmt.Fprintf(&sb, "- **Priority:** %s\n", incident.Priority.Summary)
+	}
+	if incident.Service != nil {
+		fmt.Fprintf(&sb, "- **Service:** %s\n", referenceLabel(incident.Service.Summary, incident.Service.ID))
+	}
+	fmt.Fprintf(&sb, "- **Created:** %s\n", incident.CreatedAt)
+	if incident.Status == "resolved" && !incident.LastStatusChangeAt.IsZero() {
+		fmt.Fprintf(&sb, "- **Resolved:** %s\n", incident.LastStatusChangeAt)
+	}
+	if incident.HTMLURL != "" {
+		fmt.Fprintf(&sb, "- **Link:** %s\n", incident.HTMLURL)
+	}
+
+	sb.WriteString("\n## Timeline\n\n")
+	if len(report.Timeline) == 0 {
+		sb.WriteString("No log entries.\n")
+	}
+	for _, entry := range report.Timeline {
+		summary := entry.Summary
+		if summary == "" {
+			summary = entry.Type
+		}
+		fmt.Fprintf(&sb, "- %s: %s\n", entry.CreatedAt, oneLine(summary))
+	}
+	if report.TimelineTruncated {
+		fmt.Fprintf(&sb, "\n_Only the first %d log entries are included._\n", maxReportLogEntries)
+	}
+
+	sb.WriteString("\n## Notes\n\n")
+	if len(report.Notes) == 0 {
+		sb.WriteString("No notes.\n")
+	}
+	for _, note := range report.Notes {
+		fmt.Fprintf(&sb, "- %s (%s): %s\n", note.CreatedAt, referenceLabel(note.User.Summary, note.User.ID), oneLine(note.Content))
+	}
+
+	sb.WriteString("\n## Related Changes\n\n")
+	if len(report.ChangeEvents) == 0 {
+		sb.WriteString("No related change events.\n")
+	}
+	for _, event := range report.ChangeEvents {
+		fmt.Fprintf(&sb, "- %s: %s\n", event.Timestamp, oneLine(event.Summary))
+	}
+
+	sb.WriteString("\n## Status Updates\n\n")
+	if len(report.StatusUpdates) == 0 {
+		sb.WriteString("No status updates.\n")
+	}
+	for _, update := range report.StatusUpdates {
+		fmt.Fprintf(&sb, "- %s: %s\n", update.CreatedAt, oneLine(update.Message))
+	}
+
+	if len(report.Warnings) > 0 {
+		sb.WriteString("\n## Warnings\n\n")
+		for _, warning := range report.Warnings {
+			fmt.Fprintf(&sb, "- %s\n", warning)
+		}
+	}
+	return sb.String()
+}
+
+// oneLine collapses whitespace so multi-line text stays within a list item
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,91 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// reportTestHandler serves an incident with one of each report section;
+// status updates fail to show that a missing section becomes a warning
+func reportTestHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents/PINC1":
+			w.Write([]byte(`{"incident":{"id":"PINC1","incident_number":42,"title":"Checkout errors","status":"resolved","created_at":"2024-01-15T10:00:00Z"}}`))
+		case "/incidents/PINC1/log_entries":
+			w.Write([]byte(`{"log_entries":[
+				{"id":"L2","type":"resolve_log_entry","summary":"Resolved by Alice","created_at":"2024-01-15T10:30:00Z"},
+				{"id":"L1","type":"trigger_log_entry","summary":"Triggered through the API","created_at":"2024-01-15T10:00:00Z"}
+			],"more":false}`))
+		case "/incidents/PINC1/notes":
+			w.Write([]byte(`{"notes":[{"id":"N1","user":{"id":"PUSER1","summary":"Alice"},"content":"Rolled back\ndeploy 1.4.2","created_at":"2024-01-15T10:20:00Z"}]}`))
+		case "/incidents/PINC1/related_change_events":
+			w.Write([]byte(`{"change_events":[{"id":"CE1","summary":"Deploy 1.4.2","timestamp":"2024-01-15T09:55:00Z"}]}`))
+		case "/incidents/PINC1/status_updates":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error":{"message":"Forbidden","code":2010}}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	}
+}
+
+// TestExportIncidentReport_JSON tests that every section is gathered, the timeline
+// is chronological, and a failed section is reported as a warning
+func TestExportIncidentReport_JSON(t *testing.T) {
+	c := newTestClient(t, reportTestHandler(t))
+
+	result, err := exportIncidentReportHandler(c)(context.Background(), newToolRequest(map[string]any{"incident_id": "PINC1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var report models.IncidentReport
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &report); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if report.Incident.ID != "PINC1" || len(report.Notes) != 1 || len(report.ChangeEvents) != 1 {
+		t.Errorf("Unexpected report %+v", report)
+	}
+	if len(report.Timeline) != 2 || report.Timeline[0].ID != "L1" {
+		t.Errorf("Expected a chronological timeline starting with L1, got %+v", report.Timeline)
+	}
+	if len(report.Warnings) != 1 || !strings.HasPrefix(report.Warnings[0], "status_updates unavailable") {
+		t.Errorf("Expected a status_updates warning, got %v", report.Warnings)
+	}
+}
+
+// TestExportIncidentReport_Markdown tests the markdown rendering of the report
+func TestExportIncidentReport_Markdown(t *testing.T) {
+	c := newTestClient(t, reportTestHandler(t))
+
+	result, err := exportIncidentReportHandler(c)(context.Background(), newToolRequest(map[string]any{"incident_id": "PINC1", "format": "markdown"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	text := result.Content[0].(mcp.TextContent).Text
+	for _, want := range []string{
+		"# Incident #42: Checkout errors",
+		"- 2024-01-15T10:00:00Z: Triggered through the API",
+		"- 2024-01-15T10:20:00Z (Alice): Rolled back deploy 1.4.2",
+		"- 2024-01-15T09:55:00Z: Deploy 1.4.2",
+		"## Warnings",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected markdown to contain %q, got:\n%s", want, text)
+		}
+	}
+}
@@ -50,18 +50,19 @@ func listIncidentWorkflowsHandler(c *client.Client) server.ToolHandlerFunc {
 		if v, ok := getString(args, "query"); ok {
 			params["query"] = v
 		}
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
 		}
 
 		var resp models.IncidentWorkflowsResponse
-		if err := c.GetJSON("/incident_workflows", params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, "/incident_workflows", params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		result := models.ListResponse[models.IncidentWorkflow]{Response: resp.IncidentWorkflows}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		result := models.ListResponse[models.IncidentWorkflow]{Response: resp.IncidentWorkflows}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
 	}
 }
 
@@ -74,8 +75,8 @@ func getIncidentWorkflowHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.IncidentWorkflowResponse
-		if err := c.GetJSON(fmt.Sprintf("/incident_workflows/%s", workflowID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incident_workflows/%s", workflowID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.IncidentWorkflow)
@@ -110,8 +111,8 @@ func startIncidentWorkflowHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.IncidentWorkflowInstanceResponse
-		if err := c.PostJSON(fmt.Sprintf("/incident_workflows/%s/instances", workflowID), req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PostJSONWithContext(ctx, fmt.Sprintf("/incident_workflows/%s/instances", workflowID), req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.IncidentWorkflowInstance)
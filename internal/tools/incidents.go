@@ -4,7 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/auth"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,7 +20,7 @@ import (
 func RegisterIncidentReadTools(s *server.MCPServer, c *client.Client) {
 	// list_incidents
 	s.AddTool(mcp.NewTool("list_incidents",
-		mcp.WithDescription("List incidents from PagerDuty with optional filtering. Use this to find active incidents (triggered/acknowledged), review incident history, or search for incidents affecting specific services or teams. For investigating a specific incident's history, use get_past_incidents instead."),
+		mcp.WithDescription("List incidents from PagerDuty with optional filtering. Use this to find active incidents (triggered/acknowledged), review incident history, or search for incidents affecting specific services or teams. Use request_scope 'assigned' for the current user's incidents or 'teams' for their teams' incidents. For investigating a specific incident's history, use get_past_incidents instead."),
 		mcp.WithTitleAnnotation("List Incidents"),
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithString("statuses", mcp.Description("Filter by incident status. Comma-separated values (e.g., 'triggered,acknowledged')"), mcp.Enum("triggered", "acknowledged", "resolved")),
@@ -23,20 +28,64 @@ func RegisterIncidentReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("since", mcp.Description("Start date in ISO 8601 format (e.g., '2024-01-15T10:00:00Z'). Use with 'until' for custom date ranges.")),
 		mcp.WithString("until", mcp.Description("End date in ISO 8601 format (e.g., '2024-01-15T18:00:00Z'). Use with 'since' for custom date ranges.")),
 		mcp.WithString("urgencies", mcp.Description("Filter by urgency level. Comma-separated values (e.g., 'high,low')"), mcp.Enum("high", "low")),
+		mcp.WithString("incident_key", mcp.Description("Filter by de-duplication key to find every incident sharing it (e.g., 'disk-full-web-01'). Also matches incidents whose alerts have this alert key.")),
 		mcp.WithString("service_ids", mcp.Description("Filter by services. Comma-separated service IDs (e.g., 'PDSVC1,PDSVC2')")),
 		mcp.WithString("team_ids", mcp.Description("Filter by teams. Comma-separated team IDs (e.g., 'PTEAM1,PTEAM2')")),
 		mcp.WithString("user_ids", mcp.Description("Filter by assigned users. Comma-separated user IDs (e.g., 'PUSER1,PUSER2')")),
+		mcp.WithString("priority_ids", mcp.Description("Filter by priorities. Comma-separated priority IDs (e.g., 'PPRIO1,PPRIO2')")),
+		mcp.WithString("sort_by", mcp.Description("Sort order. Comma-separated 'field:direction' where field is incident_number, created_at, resolved_at, or urgency (e.g., 'created_at:desc', 'urgency:asc')")),
+		mcp.WithString("time_zone", mcp.Description(timeZoneDescription)),
+		mcp.WithString("request_scope", mcp.Description("Scope to the current user: 'assigned' filters to incidents assigned to them, 'teams' to their teams' incidents. Combined with user_ids or team_ids, only the overlap is listed"), mcp.Enum("all", "assigned", "teams")),
+		mcp.WithString("include", mcp.Description("Related objects to include inline. Comma-separated: 'assignees', 'acknowledgers', 'services', 'teams', 'first_trigger_log_entries' (e.g., 'assignees,services')")),
+		mcp.WithString("fields", mcp.Description("Fields to return for each incident, with dot notation for nested fields. Comma-separated (e.g., 'id,title,status,urgency,service.summary'). Returns all fields when omitted. With csv or markdown, these are the table columns.")),
+		mcp.WithString("format", mcp.Description("Output format (default: json). csv and markdown return a table with columns incident_number, title, status, urgency, service.summary, created_at unless fields is set."), mcp.Enum("json", "csv", "markdown")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return (default: 20)"), mcp.Min(1), mcp.Max(100)),
+		mcp.WithNumber("offset", mcp.Description("Number of records to skip. Pass next_offset from the previous response to fetch the next page."), mcp.Min(0)),
 	), listIncidentsHandler(c))
 
 	// get_incident
 	s.AddTool(mcp.NewTool("get_incident",
-		mcp.WithDescription("Get detailed information about a specific incident by ID, including status, assignments, urgency, and timestamps."),
+		mcp.WithDescription("Get detailed information about a specific incident, including status, assignments, urgency, and timestamps. Look up by incident_id or by the human-facing incident_number (provide exactly one)."),
 		mcp.WithTitleAnnotation("Get Incident Details"),
 		mcp.WithReadOnlyHintAnnotation(true),
-		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+		mcp.WithString("incident_id", mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+		mcp.WithNumber("incident_number", mcp.Description("The sequential incident number shown in the UI (e.g., 4271)"), mcp.Min(1)),
+		mcp.WithString("time_zone", mcp.Description(timeZoneDescription)),
 	), getIncidentHandler(c))
 
+	// get_incident_alert_summary
+	s.AddTool(mcp.NewTool("get_incident_alert_summary",
+		mcp.WithDescription("Get an incident's alert counts (all, triggered, resolved) with its title, status, and urgency. A quick triage view that avoids fetching every alert."),
+		mcp.WithTitleAnnotation("Get Incident Alert Summary"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+	), getIncidentAlertSummaryHandler(c))
+
+	// get_incident_business_impact
+	s.AddTool(mcp.NewTool("get_incident_business_impact",
+		mcp.WithDescription("Find the customer-facing business services affected by an incident. Walks the service graph from the incident's service through the services that depend on it and lists every business service reached, with the dependency path. Answers 'what customer-facing thing is broken?' during triage. incident_status_derived reflects only this incident: 'recovered' once it resolves, even if another open incident still affects the business service."),
+		mcp.WithTitleAnnotation("Get Incident Business Impact"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+	), getIncidentBusinessImpactHandler(c))
+
+	// export_incident_report
+	s.AddTool(mcp.NewTool("export_incident_report",
+		mcp.WithDescription("Export a consolidated post-incident review document: the incident, its timeline of log entries, notes, related change events, and status updates. Sections that can't be fetched are listed as warnings rather than failing the export. The timeline is limited to the first 100 overview log entries."),
+		mcp.WithTitleAnnotation("Export Incident Report"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+		mcp.WithString("format", mcp.Description("Output format (default: json)"), mcp.Enum("json", "markdown")),
+	), exportIncidentReportHandler(c))
+
+	// get_incident_duration
+	s.AddTool(mcp.NewTool("get_incident_duration",
+		mcp.WithDescription("Compute how long an incident spent triggered versus acknowledged, plus time to acknowledge and time to resolve, from its log of status changes. Open incidents are measured up to now. Durations are in seconds."),
+		mcp.WithTitleAnnotation("Get Incident Duration"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+	), getIncidentDurationHandler(c))
+
 	// get_outlier_incident
 	s.AddTool(mcp.NewTool("get_outlier_incident",
 		mcp.WithDescription("Analyze if an incident is an outlier compared to historical patterns. Returns machine learning-based analysis of whether this incident is unusual for the service."),
@@ -53,6 +102,9 @@ func RegisterIncidentReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of past incidents to return (default: 5)"), mcp.Min(1), mcp.Max(100)),
+		mcp.WithBoolean("total", mcp.Description("If true, include the total number of similar past incidents in 'total'")),
+		mcp.WithString("additional_details", mcp.Description("Extra details to include for each incident. Comma-separated (e.g., 'incident')")),
+		mcp.WithString("time_zone", mcp.Description(timeZoneDescription)),
 	), getPastIncidentsHandler(c))
 
 	// get_related_incidents
@@ -61,6 +113,8 @@ func RegisterIncidentReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithTitleAnnotation("Get Related Incidents"),
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+		mcp.WithString("additional_details", mcp.Description("Extra details to include for each incident. Comma-separated (e.g., 'incident')")),
+		mcp.WithString("time_zone", mcp.Description(timeZoneDescription)),
 	), getRelatedIncidentsHandler(c))
 
 	// list_incident_notes
@@ -70,6 +124,62 @@ func RegisterIncidentReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
 	), listIncidentNotesHandler(c))
+
+	// list_priorities
+	s.AddTool(mcp.NewTool("list_priorities",
+		mcp.WithDescription("List the incident priority levels configured for the account (e.g., P1-P5). Use to find priority IDs for manage_incidents or the priority_ids filter on list_incidents."),
+		mcp.WithTitleAnnotation("List Priorities"),
+		mcp.WithReadOnlyHintAnnotation(true),
+	), listPrioritiesHandler(c))
+
+	// list_incident_subscribers
+	s.AddTool(mcp.NewTool("list_incident_subscribers",
+		mcp.WithDescription("List users and teams subscribed to an incident's status updates. Subscribers receive updates posted with add_incident_status_update."),
+		mcp.WithTitleAnnotation("List Incident Subscribers"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+	), listIncidentSubscribersHandler(c))
+
+	// list_incident_notifications
+	s.AddTool(mcp.NewTool("list_incident_notifications",
+		mcp.WithDescription("List the notifications PagerDuty sent for an incident: who was notified, by which channel (SMS, email, phone, push), and whether delivery succeeded. Use in post-incident review to confirm the page actually went out."),
+		mcp.WithTitleAnnotation("List Incident Notifications"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+	), listIncidentNotificationsHandler(c))
+
+	// list_incident_responder_requests
+	s.AddTool(mcp.NewTool("list_incident_responder_requests",
+		mcp.WithDescription("List the responders requested on an incident: who was asked, who asked them, and whether they are pending, have joined, or declined. Use to report who has been pulled in after add_responders."),
+		mcp.WithTitleAnnotation("List Incident Responder Requests"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+		mcp.WithString("state", mcp.Description("Only return responders in this state"), mcp.Enum(models.ResponderStatePending, models.ResponderStateJoined, models.ResponderStateDeclined)),
+	), listIncidentResponderRequestsHandler(c))
+
+	// get_log_entry
+	s.AddTool(mcp.NewTool("get_log_entry",
+		mcp.WithDescription("Get the full detail of a single log entry, such as a trigger or notification, by an ID from list_log_entries. Include 'channels' to see exactly how an alert arrived (email subject and body, API or integration client and details)."),
+		mcp.WithTitleAnnotation("Get Log Entry"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("log_entry_id", mcp.Required(), mcp.Description("The log entry ID (e.g., 'R2KJ0UJUD4PVPXNJCYHQKOD2KV')")),
+		mcp.WithString("include", mcp.Description("Related objects to include inline. Comma-separated: 'channels', 'incidents' (e.g., 'channels')")),
+		mcp.WithString("time_zone", mcp.Description(timeZoneDescription)),
+	), getLogEntryHandler(c))
+
+	// list_log_entries
+	s.AddTool(mcp.NewTool("list_log_entries",
+		mcp.WithDescription("List log entries across the whole account: triggers, acknowledgements, notifications, reassignments and resolutions on every incident. Use to answer 'what happened in the last hour across all incidents' or for audits. Use get_log_entry for the full detail of one entry."),
+		mcp.WithTitleAnnotation("List Log Entries"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("since", mcp.Description("Start date in ISO 8601 format (e.g., '2024-01-15T00:00:00Z')")),
+		mcp.WithString("until", mcp.Description("End date in ISO 8601 format (e.g., '2024-01-16T00:00:00Z')")),
+		mcp.WithString("team_ids", mcp.Description("Filter by team IDs. Comma-separated (e.g., 'PTEAM1,PTEAM2')")),
+		mcp.WithBoolean("is_overview", mcp.Description("Return only the most important changes to each incident, omitting entries such as individual notifications (default: false)")),
+		mcp.WithString("time_zone", mcp.Description(timeZoneDescription)),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return (default: 25)"), mcp.Min(1), mcp.Max(100)),
+		mcp.WithNumber("offset", mcp.Description("Number of records to skip. Pass next_offset from the previous response to fetch the next page."), mcp.Min(0)),
+	), listLogEntriesHandler(c))
 }
 
 // RegisterIncidentWriteTools registers write incident tools
@@ -83,19 +193,43 @@ func RegisterIncidentWriteTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("urgency", mcp.Description("Incident urgency level"), mcp.Enum("high", "low")),
 		mcp.WithString("body", mcp.Description("Detailed description of the incident including symptoms, impact, and any relevant context")),
 		mcp.WithString("incident_key", mcp.Description("Deduplication key to prevent duplicate incidents. Incidents with the same key on the same service will be grouped.")),
+		mcp.WithString("priority_id", mcp.Description("Priority ID to create the incident at (e.g., 'PPRIO123'). Get valid IDs from list_priorities.")),
+		mcp.WithString("assignee_id", mcp.Description("User ID to assign the incident to directly instead of following the escalation policy (e.g., 'PUSER123')")),
+		mcp.WithString("conference_number", mcp.Description("Phone number for the incident's conference bridge, with any access code (e.g., '+1-555-123-4567,,123456#')")),
+		mcp.WithString("conference_url", mcp.Description("URL for the incident's conference bridge (e.g., 'https://meet.example.com/incident-room')")),
+		mcp.WithBoolean("dedup_check", mcp.Description("Before creating, look for an open incident with the same incident_key on the service and return it instead of creating a new one. Requires incident_key. The result is wrapped as {deduplicated, incident}.")),
 	), createIncidentHandler(c))
 
 	// manage_incidents
 	s.AddTool(mcp.NewTool("manage_incidents",
-		mcp.WithDescription("Bulk update one or more incidents. Use to acknowledge incidents you're working on, resolve incidents that are fixed, change urgency, reassign to other users, or escalate to higher levels. Cannot change status to 'triggered' - use create_incident instead."),
+		mcp.WithDescription("Bulk update one or more incidents. Use to acknowledge incidents you're working on, resolve incidents that are fixed, change urgency, reassign to other users or escalation policies, or escalate to higher levels. Cannot change status to 'triggered' - use create_incident instead."),
 		mcp.WithTitleAnnotation("Manage Incidents"),
 		mcp.WithString("incident_ids", mcp.Required(), mcp.Description("Comma-separated incident IDs to update (e.g., 'PABC123,PDEF456')")),
 		mcp.WithString("status", mcp.Description("New incident status"), mcp.Enum("acknowledged", "resolved")),
 		mcp.WithString("urgency", mcp.Description("New urgency level"), mcp.Enum("high", "low")),
 		mcp.WithString("assignee_id", mcp.Description("User ID to assign/reassign the incidents to (e.g., 'PUSER123')")),
 		mcp.WithNumber("escalation_level", mcp.Description("Escalation level to set (escalates to users at that level in the escalation policy)"), mcp.Min(1)),
+		mcp.WithString("escalation_policy_id", mcp.Description("Escalation policy ID to reassign the incidents to (e.g., 'PESCPOL123'). Cannot be combined with assignee_id.")),
+		mcp.WithString("priority_id", mcp.Description("Priority ID to set (e.g., 'PPRIO123'). Get valid IDs from list_priorities.")),
 	), manageIncidentsHandler(c))
 
+	// acknowledge_incident
+	s.AddTool(mcp.NewTool("acknowledge_incident",
+		mcp.WithDescription("Acknowledge a single incident to signal you're working on it. Shortcut for manage_incidents with status 'acknowledged'."),
+		mcp.WithTitleAnnotation("Acknowledge Incident"),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+	), acknowledgeIncidentHandler(c))
+
+	// resolve_incident
+	s.AddTool(mcp.NewTool("resolve_incident",
+		mcp.WithDescription("Resolve a single incident. Optionally records a resolution note on the incident. Shortcut for manage_incidents with status 'resolved'."),
+		mcp.WithTitleAnnotation("Resolve Incident"),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+		mcp.WithString("resolution", mcp.Description("Resolution note describing the fix (e.g., 'Rolled back deploy 1.4.2')")),
+	), resolveIncidentHandler(c))
+
 	// add_responders
 	s.AddTool(mcp.NewTool("add_responders",
 		mcp.WithDescription("Request additional responders to help with an incident. The specified users will receive notifications asking them to join the incident response."),
@@ -103,6 +237,7 @@ func RegisterIncidentWriteTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
 		mcp.WithString("responder_ids", mcp.Required(), mcp.Description("Comma-separated user IDs to request as responders (e.g., 'PUSER1,PUSER2')")),
 		mcp.WithString("message", mcp.Description("Optional message explaining why these responders are needed")),
+		mcp.WithString("requester_id", mcp.Description("User ID making the request (e.g., 'PUSER9'). Defaults to the current user; required with account-level API tokens.")),
 	), addRespondersHandler(c))
 
 	// add_note_to_incident
@@ -112,44 +247,247 @@ func RegisterIncidentWriteTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
 		mcp.WithString("note", mcp.Required(), mcp.Description("The note content to add to the incident")),
 	), addNoteToIncidentHandler(c))
+
+	// update_incident_note
+	s.AddTool(mcp.NewTool("update_incident_note",
+		mcp.WithDescription("Replace the content of an existing incident note, e.g. to correct a typo in an investigation note. PagerDuty does not allow notes to be deleted, so correct a wrong note by updating it instead."),
+		mcp.WithTitleAnnotation("Update Incident Note"),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+		mcp.WithString("note_id", mcp.Required(), mcp.Description("The note ID from list_incident_notes (e.g., 'PNOTE12')")),
+		mcp.WithString("note", mcp.Required(), mcp.Description("The new note content, replacing the existing content")),
+		mcp.WithString("from_email", mcp.Description("Email of the PagerDuty user making the change (e.g., 'alice@example.com'). Only used when the request carries no identity of its own; defaults to the current user.")),
+	), updateIncidentNoteHandler(c))
+
+	// add_incident_status_update
+	s.AddTool(mcp.NewTool("add_incident_status_update",
+		mcp.WithDescription("Broadcast a status update to stakeholders subscribed to an incident. Unlike notes (internal to responders), status updates are sent to subscribers by email and other channels."),
+		mcp.WithTitleAnnotation("Add Incident Status Update"),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+		mcp.WithString("message", mcp.Required(), mcp.Description("The status update message sent to subscribers")),
+		mcp.WithString("subject", mcp.Description("Email subject for the update (e.g., 'Checkout degraded - mitigation in progress')")),
+		mcp.WithString("from_email", mcp.Description("Email of the PagerDuty user sending the update (e.g., 'alice@example.com'). Only used when the request carries no identity of its own; defaults to the current user.")),
+	), addIncidentStatusUpdateHandler(c))
+
+	// add_incident_subscribers
+	s.AddTool(mcp.NewTool("add_incident_subscribers",
+		mcp.WithDescription("Subscribe users and/or teams to an incident's status updates so they are kept informed. Provide user_ids, team_ids, or both."),
+		mcp.WithTitleAnnotation("Add Incident Subscribers"),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+		mcp.WithString("user_ids", mcp.Description("Users to subscribe. Comma-separated user IDs (e.g., 'PUSER1,PUSER2')")),
+		mcp.WithString("team_ids", mcp.Description("Teams to subscribe. Comma-separated team IDs (e.g., 'PTEAM1,PTEAM2')")),
+	), addIncidentSubscribersHandler(c))
+
+	// remove_incident_subscribers
+	s.AddTool(mcp.NewTool("remove_incident_subscribers",
+		mcp.WithDescription("WARNING: DESTRUCTIVE - Unsubscribe users and/or teams from an incident's status updates. They will stop receiving updates for this incident."),
+		mcp.WithTitleAnnotation("Remove Incident Subscribers"),
+		mcp.WithDestructiveHintAnnotation(true),
+		mcp.WithString("incident_id", mcp.Required(), mcp.Description("The unique incident ID (e.g., 'PABC123')")),
+		mcp.WithString("user_ids", mcp.Description("Users to unsubscribe. Comma-separated user IDs (e.g., 'PUSER1,PUSER2')")),
+		mcp.WithString("team_ids", mcp.Description("Teams to unsubscribe. Comma-separated team IDs (e.g., 'PTEAM1,PTEAM2')")),
+	), removeIncidentSubscribersHandler(c))
 }
 
+// incidentTableColumns are the list_incidents columns for csv and markdown output
+var incidentTableColumns = []string{"incident_number", "title", "status", "urgency", "service.summary", "created_at"}
+
 func listIncidentsHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
-		params := make(map[string]string)
+		query := models.IncidentQuery{}
 
-		if v, ok := getString(args, "statuses"); ok {
-			params["statuses[]"] = v
+		format, err := getTableFormat(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if v, ok := getStringArray(args, "statuses"); ok {
+			query.Statuses = v
 		}
 		if v, ok := getString(args, "date_range"); ok {
-			params["date_range"] = v
+			query.DateRange = v
 		}
-		if v, ok := getString(args, "since"); ok {
-			params["since"] = v
+		if v, ok, err := getDateTime(args, "since"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Since = v
+		}
+		if v, ok, err := getDateTime(args, "until"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Until = v
+		}
+		if v, ok := getStringArray(args, "urgencies"); ok {
+			query.Urgencies = v
+		}
+		if v, ok := getString(args, "incident_key"); ok {
+			query.IncidentKey = v
 		}
-		if v, ok := getString(args, "until"); ok {
-			params["until"] = v
+		if v, ok := getStringArray(args, "service_ids"); ok {
+			query.ServiceIDs = v
 		}
-		if v, ok := getString(args, "urgencies"); ok {
-			params["urgencies[]"] = v
+		if v, ok := getStringArray(args, "team_ids"); ok {
+			query.TeamIDs = v
 		}
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok := getStringArray(args, "user_ids"); ok {
+			query.UserIDs = v
+		}
+		if v, ok := getStringArray(args, "priority_ids"); ok {
+			query.PriorityIDs = v
+		}
+		if v, ok := getString(args, "sort_by"); ok {
+			if err := models.ValidateIncidentSortBy(v); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query.SortBy = v
+		}
+		if loc, ok, err := getTimeZone(args); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.TimeZone = loc.String()
+		}
+		if v, ok := getStringArray(args, "include"); ok {
+			query.Includes = v
+		}
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Limit = v
+		}
+		if v, ok, err := getInteger(args, "offset", 0, math.MaxInt); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Offset = v
+		}
+		if v, ok := getString(args, "request_scope"); ok {
+			query.RequestScope = v
+			if err := applyIncidentRequestScope(ctx, c, &query); err != nil {
+				return toolError(err), nil
+			}
+		}
+
+		data, err := c.GetWithArrayParamsContext(ctx, "/incidents", query.ToArrayParams())
+		if err != nil {
+			return toolError(err), nil
 		}
 
 		var resp models.IncidentsResponse
-		if err := c.GetJSON("/incidents", params, &resp); err != nil {
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return toolError(fmt.Errorf("failed to parse response: %w", err)), nil
+		}
+
+		result := models.ListResponse[models.Incident]{Response: resp.Incidents}.WithPage(resp.Offset, resp.More, resp.Total)
+
+		if format != "json" {
+			columns := incidentTableColumns
+			if v, ok := getString(args, "fields"); ok {
+				columns = splitAndTrim(v)
+			}
+			table, err := tableResult(result, columns, format)
+			if err != nil {
+				return toolError(err), nil
+			}
+			return table, nil
+		}
+
+		if v, ok := getString(args, "fields"); ok {
+			projected, err := projectFields(resp.Incidents, splitAndTrim(v))
+			if err != nil {
+				return toolError(err), nil
+			}
+			return listResult(models.ListResponse[any]{Response: projected}.WithPage(resp.Offset, resp.More, resp.Total)), nil
+		}
+
+		return listResult(result), nil
+	}
+}
+
+// applyIncidentRequestScope narrows the query to the current user's incidents or
+// teams. Explicit user_ids or team_ids are intersected with the scope rather
+// than replaced, and an empty intersection is an error.
+func applyIncidentRequestScope(ctx context.Context, c *client.Client, query *models.IncidentQuery) error {
+	switch query.RequestScope {
+	case "", "all":
+		return nil
+	case "assigned", "teams":
+	default:
+		return fmt.Errorf("invalid request_scope: expected 'all', 'assigned', or 'teams'")
+	}
+
+	var me models.UserResponse
+	if err := c.GetJSONWithContext(ctx, "/users/me", nil, &me); err != nil {
+		return fmt.Errorf("failed to resolve current user: %w", err)
+	}
+
+	if query.RequestScope == "assigned" {
+		if len(query.UserIDs) > 0 && !slices.Contains(query.UserIDs, me.User.ID) {
+			return fmt.Errorf("request_scope 'assigned' limits results to user %s, which is not in user_ids", me.User.ID)
+		}
+		query.UserIDs = []string{me.User.ID}
+		return nil
+	}
+
+	if len(me.User.Teams) == 0 {
+		return fmt.Errorf("current user %s does not belong to any teams", me.User.ID)
+	}
+	teamIDs := make([]string, 0, len(me.User.Teams))
+	for _, t := range me.User.Teams {
+		if len(query.TeamIDs) == 0 || slices.Contains(query.TeamIDs, t.ID) {
+			teamIDs = append(teamIDs, t.ID)
+		}
+	}
+	if len(teamIDs) == 0 {
+		return fmt.Errorf("request_scope 'teams' limits results to the current user's teams, none of which are in team_ids")
+	}
+	query.TeamIDs = teamIDs
+	return nil
+}
+
+func getIncidentHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, hasID := getString(args, "incident_id")
+		incidentNumber, hasNumber, err := getInteger(args, "incident_number", 1, math.MaxInt)
+		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
 
-		result := models.ListResponse[models.Incident]{Response: resp.Incidents}
-		data, _ := json.Marshal(result)
+		if hasID && hasNumber {
+			return mcp.NewToolResultError("provide either incident_id or incident_number, not both"), nil
+		}
+		if !hasID && !hasNumber {
+			return mcp.NewToolResultError("incident_id or incident_number is required"), nil
+		}
+		loc, hasZone, err := getTimeZone(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// The incidents endpoint also resolves sequential incident numbers
+		if hasNumber {
+			incidentID = fmt.Sprintf("%d", incidentNumber)
+		}
+
+		var resp models.IncidentResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s", incidentID), nil, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		if hasNumber && resp.Incident.IncidentNumber != incidentNumber {
+			return mcp.NewToolResultError(fmt.Sprintf("no incident found with incident_number %d", incidentNumber)), nil
+		}
+		if hasZone {
+			localizeIncident(&resp.Incident, loc)
+		}
+
+		data, _ := json.Marshal(resp.Incident)
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
 
-func getIncidentHandler(c *client.Client) server.ToolHandlerFunc {
+func getIncidentAlertSummaryHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
 		incidentID, ok := getString(args, "incident_id")
@@ -158,11 +496,23 @@ func getIncidentHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.IncidentResponse
-		if err := c.GetJSON(fmt.Sprintf("/incidents/%s", incidentID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s", incidentID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		data, _ := json.Marshal(resp.Incident)
+		incident := resp.Incident
+		summary := models.IncidentAlertSummary{
+			IncidentID:     incident.ID,
+			IncidentNumber: incident.IncidentNumber,
+			Title:          incident.Title,
+			Status:         incident.Status,
+			Urgency:        incident.Urgency,
+		}
+		if incident.AlertCounts != nil {
+			summary.AlertCounts = *incident.AlertCounts
+		}
+
+		data, _ := json.Marshal(summary)
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
@@ -176,13 +526,15 @@ func getOutlierIncidentHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		params := make(map[string]string)
-		if v, ok := getString(args, "since"); ok {
+		if v, ok, err := getDateTime(args, "since"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
 			params["since"] = v
 		}
 
 		var resp models.OutlierIncidentResponse
-		if err := c.GetJSON(fmt.Sprintf("/incidents/%s/outlier_incident", incidentID), params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/outlier_incident", incidentID), params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp)
@@ -198,14 +550,31 @@ func getPastIncidentsHandler(c *client.Client) server.ToolHandlerFunc {
 			return mcp.NewToolResultError("incident_id is required"), nil
 		}
 
-		params := make(map[string]string)
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		var query models.PastIncidentsQuery
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Limit = v
+		}
+		if v, ok := getBool(args, "total"); ok {
+			query.Total = v
+		}
+		if v, ok := getStringArray(args, "additional_details"); ok {
+			query.AdditionalDetails = v
+		}
+		loc, hasZone, err := getTimeZone(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
 		var resp models.PastIncidentsResponse
-		if err := c.GetJSON(fmt.Sprintf("/incidents/%s/past_incidents", incidentID), params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithArrayParamsContext(ctx, fmt.Sprintf("/incidents/%s/past_incidents", incidentID), query.ToArrayParams(), &resp); err != nil {
+			return toolError(err), nil
+		}
+		if hasZone {
+			for i := range resp.PastIncidents {
+				localizeIncident(&resp.PastIncidents[i].Incident, loc)
+			}
 		}
 
 		data, _ := json.Marshal(resp)
@@ -221,11 +590,25 @@ func getRelatedIncidentsHandler(c *client.Client) server.ToolHandlerFunc {
 			return mcp.NewToolResultError("incident_id is required"), nil
 		}
 
-		var resp models.RelatedIncidentsResponse
-		if err := c.GetJSON(fmt.Sprintf("/incidents/%s/related_incidents", incidentID), nil, &resp); err != nil {
+		var query models.RelatedIncidentsQuery
+		if v, ok := getStringArray(args, "additional_details"); ok {
+			query.AdditionalDetails = v
+		}
+		loc, hasZone, err := getTimeZone(args)
+		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
 
+		var resp models.RelatedIncidentsResponse
+		if err := c.GetJSONWithArrayParamsContext(ctx, fmt.Sprintf("/incidents/%s/related_incidents", incidentID), query.ToArrayParams(), &resp); err != nil {
+			return toolError(err), nil
+		}
+		if hasZone {
+			for i := range resp.RelatedIncidents {
+				localizeIncident(&resp.RelatedIncidents[i].Incident, loc)
+			}
+		}
+
 		data, _ := json.Marshal(resp)
 		return mcp.NewToolResultText(string(data)), nil
 	}
@@ -240,13 +623,24 @@ func listIncidentNotesHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.IncidentNotesResponse
-		if err := c.GetJSON(fmt.Sprintf("/incidents/%s/notes", incidentID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/notes", incidentID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		result := models.ListResponse[models.IncidentNote]{Response: resp.Notes}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		return listResult(result), nil
+	}
+}
+
+func listPrioritiesHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var resp models.PrioritiesResponse
+		if err := c.GetJSONWithContext(ctx, "/priorities", nil, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		result := models.ListResponse[models.Priority]{Response: resp.Priorities}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
 	}
 }
 
@@ -285,18 +679,84 @@ func createIncidentHandler(c *client.Client) server.ToolHandlerFunc {
 			incident.IncidentKey = v
 		}
 
+		if v, ok := getString(args, "priority_id"); ok {
+			incident.Priority = &models.PriorityReference{ID: v, Type: "priority_reference"}
+		}
+
+		if v, ok := getString(args, "assignee_id"); ok {
+			if !looksLikeID(v) {
+				return mcp.NewToolResultError("invalid assignee_id format: expected a PagerDuty user ID (e.g., 'PUSER123')"), nil
+			}
+			incident.Assignments = []models.Assignment{{
+				At:       time.Now().Format(time.RFC3339),
+				Assignee: models.UserReference{ID: v, Type: "user_reference"},
+			}}
+		}
+
+		conferenceNumber, hasNumber := getString(args, "conference_number")
+		conferenceURL, hasURL := getString(args, "conference_url")
+		if hasURL {
+			if !isHTTPURL(conferenceURL) {
+				return mcp.NewToolResultError("invalid conference_url format: expected an http(s) URL"), nil
+			}
+		}
+		if hasNumber || hasURL {
+			incident.ConferenceBridge = &models.ConferenceBridge{
+				ConferenceNumber: conferenceNumber,
+				ConferenceURL:    conferenceURL,
+			}
+		}
+
+		dedupCheck, _ := getBool(args, "dedup_check")
+		if dedupCheck {
+			if incident.IncidentKey == "" {
+				return mcp.NewToolResultError("dedup_check requires incident_key"), nil
+			}
+			existing, err := findOpenIncidentByKey(ctx, c, serviceID, incident.IncidentKey)
+			if err != nil {
+				return toolError(fmt.Errorf("failed to check for an existing incident: %w", err)), nil
+			}
+			if existing != nil {
+				data, _ := json.Marshal(models.IncidentCreateResult{Deduplicated: true, Incident: *existing})
+				return mcp.NewToolResultText(string(data)), nil
+			}
+		}
+
 		req := models.IncidentCreateRequest{Incident: incident}
 
 		var resp models.IncidentResponse
-		if err := c.PostJSON("/incidents", req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PostJSONWithContext(ctx, "/incidents", req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
+		if dedupCheck {
+			data, _ := json.Marshal(models.IncidentCreateResult{Incident: resp.Incident})
+			return mcp.NewToolResultText(string(data)), nil
+		}
 		data, _ := json.Marshal(resp.Incident)
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
 
+// findOpenIncidentByKey returns the triggered or acknowledged incident on the
+// service with the given incident key, or nil if there is none
+func findOpenIncidentByKey(ctx context.Context, c *client.Client, serviceID, incidentKey string) (*models.Incident, error) {
+	params := map[string][]string{
+		"incident_key":  {incidentKey},
+		"service_ids[]": {serviceID},
+		"statuses[]":    {"triggered", "acknowledged"},
+	}
+
+	var resp models.IncidentsResponse
+	if err := c.GetJSONWithArrayParamsContext(ctx, "/incidents", params, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Incidents) == 0 {
+		return nil, nil
+	}
+	return &resp.Incidents[0], nil
+}
+
 func manageIncidentsHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
@@ -320,23 +780,104 @@ func manageIncidentsHandler(c *client.Client) server.ToolHandlerFunc {
 		if v, ok := getString(args, "assignee_id"); ok {
 			manageReq.Assignment = &models.UserReference{ID: v}
 		}
-		if v, ok := getNumber(args, "escalation_level"); ok {
-			manageReq.EscalationLevel = int(v)
+		if v, ok, err := getInteger(args, "escalation_level", 1, math.MaxInt); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			manageReq.EscalationLevel = v
+		}
+		if v, ok := getString(args, "escalation_policy_id"); ok {
+			if manageReq.Assignment != nil {
+				return mcp.NewToolResultError("assignee_id and escalation_policy_id cannot be used together"), nil
+			}
+			manageReq.EscalationPolicy = &models.EscalationPolicyReference{ID: v}
+		}
+		if v, ok := getString(args, "priority_id"); ok {
+			manageReq.Priority = &models.PriorityReference{ID: v}
 		}
 
 		payload := manageReq.ToAPIPayload()
 
 		var resp models.IncidentsResponse
-		if err := c.PutJSON("/incidents", payload, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PutJSONWithContext(ctx, "/incidents", payload, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		result := models.ListResponse[models.Incident]{Response: resp.Incidents}
-		data, _ := json.Marshal(result)
+		return listResult(result), nil
+	}
+}
+
+func acknowledgeIncidentHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+
+		incident, err := setIncidentStatus(ctx, c, incidentID, "acknowledged")
+		if err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(incident)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func resolveIncidentHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+
+		// Add the resolution note first so it's part of the incident timeline before resolution
+		resolution, hasResolution := getString(args, "resolution")
+		var noteResp struct {
+			Note models.IncidentNote `json:"note"`
+		}
+		if hasResolution {
+			req := models.IncidentNoteCreateRequest{
+				Note: models.NoteContent{Content: resolution},
+			}
+			if err := c.PostJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/notes", incidentID), req, &noteResp); err != nil {
+				return toolError(fmt.Errorf("failed to add resolution note: %w", err)), nil
+			}
+		}
+
+		incident, err := setIncidentStatus(ctx, c, incidentID, "resolved")
+		if err != nil {
+			// The note cannot be deleted, so retrying would add it a second time
+			if hasResolution {
+				return toolError(fmt.Errorf("resolution note %s was added but the incident was not resolved; retry without resolution: %w", noteResp.Note.ID, err)), nil
+			}
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(incident)
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
 
+// setIncidentStatus updates the status of a single incident and returns it
+func setIncidentStatus(ctx context.Context, c *client.Client, incidentID, status string) (*models.Incident, error) {
+	manageReq := models.IncidentManageRequest{
+		IncidentIDs: []string{incidentID},
+		Status:      status,
+	}
+
+	var resp models.IncidentsResponse
+	if err := c.PutJSONWithContext(ctx, "/incidents", manageReq.ToAPIPayload(), &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Incidents) == 0 {
+		return nil, fmt.Errorf("incident %s was not updated", incidentID)
+	}
+	return &resp.Incidents[0], nil
+}
+
 func addRespondersHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
@@ -359,17 +900,31 @@ func addRespondersHandler(c *client.Client) server.ToolHandlerFunc {
 			}
 		}
 
+		// PagerDuty rejects responder requests without a requester
+		requesterID, ok := getString(args, "requester_id")
+		if ok && !looksLikeID(requesterID) {
+			return mcp.NewToolResultError(fmt.Sprintf("requester_id must be a PagerDuty user ID (e.g., 'PUSER123'), got '%s'", requesterID)), nil
+		}
+		if !ok {
+			var me models.UserResponse
+			if err := c.GetJSONWithContext(ctx, "/users/me", nil, &me); err != nil {
+				return toolError(fmt.Errorf("failed to resolve requester (pass requester_id when using an account-level token): %w", err)), nil
+			}
+			requesterID = me.User.ID
+		}
+
 		req := models.IncidentResponderRequest{
-			Targets: targets,
+			RequesterID: requesterID,
+			Targets:     targets,
 		}
 
 		if v, ok := getString(args, "message"); ok {
 			req.Message = v
 		}
 
-		data, err := c.Post(fmt.Sprintf("/incidents/%s/responder_requests", incidentID), req)
+		data, err := c.PostWithContext(ctx, fmt.Sprintf("/incidents/%s/responder_requests", incidentID), req)
 		if err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+			return toolError(err), nil
 		}
 
 		return mcp.NewToolResultText(string(data)), nil
@@ -396,11 +951,374 @@ func addNoteToIncidentHandler(c *client.Client) server.ToolHandlerFunc {
 		var resp struct {
 			Note models.IncidentNote `json:"note"`
 		}
-		if err := c.PostJSON(fmt.Sprintf("/incidents/%s/notes", incidentID), req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PostJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/notes", incidentID), req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Note)
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
+
+func updateIncidentNoteHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+
+		noteID, ok := getString(args, "note_id")
+		if !ok {
+			return mcp.NewToolResultError("note_id is required"), nil
+		}
+
+		note, ok := getString(args, "note")
+		if !ok {
+			return mcp.NewToolResultError("note is required"), nil
+		}
+
+		// Note updates require a From header identifying the editor
+		ctx, err := withFromEmail(ctx, c, args)
+		if err != nil {
+			return toolError(err), nil
+		}
+
+		req := models.IncidentNoteUpdateRequest{
+			Note: models.NoteContent{Content: note},
+		}
+
+		var resp struct {
+			Note models.IncidentNote `json:"note"`
+		}
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/notes/%s", incidentID, noteID), req, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.Note)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+// withFromEmail attaches the From identity the API requires for some writes:
+// the identity already on the context, else the from_email argument, else the
+// current user's email. A per-request identity is never overridden, so a
+// from_email that doesn't match it is rejected.
+func withFromEmail(ctx context.Context, c *client.Client, args map[string]any) (context.Context, error) {
+	fromEmail, hasArg := getString(args, "from_email")
+	if current, ok := auth.GetFromEmail(ctx); ok {
+		if hasArg && !strings.EqualFold(fromEmail, current) {
+			return ctx, fmt.Errorf("from_email '%s' does not match the identity of this request", fromEmail)
+		}
+		return ctx, nil
+	}
+	if hasArg {
+		return auth.WithFromEmail(ctx, fromEmail), nil
+	}
+	var me models.UserResponse
+	if err := c.GetJSONWithContext(ctx, "/users/me", nil, &me); err != nil {
+		return ctx, fmt.Errorf("failed to resolve sender email: %w", err)
+	}
+	return auth.WithFromEmail(ctx, me.User.Email), nil
+}
+
+func addIncidentStatusUpdateHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+
+		message, ok := getString(args, "message")
+		if !ok {
+			return mcp.NewToolResultError("message is required"), nil
+		}
+
+		// Status updates require a From header identifying the sender
+		ctx, err := withFromEmail(ctx, c, args)
+		if err != nil {
+			return toolError(err), nil
+		}
+
+		req := models.IncidentStatusUpdateRequest{Message: message}
+		if v, ok := getString(args, "subject"); ok {
+			req.Subject = v
+		}
+
+		var resp models.IncidentStatusUpdateResponse
+		if err := c.PostJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/status_updates", incidentID), req, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.StatusUpdate)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func listIncidentSubscribersHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+
+		var resp models.IncidentSubscribersResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/status_updates/subscribers", incidentID), nil, &resp); err != nil {
+			return toolError(err), nil
+		}
+		if resp.Subscribers == nil {
+			resp.Subscribers = []models.IncidentSubscriber{}
+		}
+
+		data, _ := json.Marshal(resp)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func listIncidentNotificationsHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+
+		// Notifications are only recorded in the full (non-overview) incident log
+		notifications := []models.IncidentNotification{}
+		more := false
+		params := map[string]string{"is_overview": "false"}
+		err := c.PaginateWithContext(ctx, fmt.Sprintf("/incidents/%s/log_entries", incidentID), params, models.MaxResults, func(data []byte) (int, error) {
+			var page models.LogEntriesResponse
+			if err := json.Unmarshal(data, &page); err != nil {
+				return 0, err
+			}
+			more = page.More
+			for _, entry := range page.LogEntries {
+				if entry.Type != "notify_log_entry" || entry.Notification == nil {
+					continue
+				}
+				notifications = append(notifications, models.IncidentNotification{
+					LogEntryID: entry.ID,
+					Channel:    strings.TrimSuffix(entry.Notification.Type, "_notification"),
+					User:       entry.User,
+					Status:     entry.Notification.Status,
+					Address:    entry.Notification.Address,
+					Timestamp:  entry.CreatedAt,
+				})
+			}
+			return len(page.LogEntries), nil
+		})
+		if err != nil {
+			return toolError(err), nil
+		}
+
+		// Paging stops at MaxResults log entries with more still to read
+		result := models.IncidentNotifications{
+			Notifications: notifications,
+			LogTruncated:  more,
+			Summary:       fmt.Sprintf("%d notification(s) sent for incident %s", len(notifications), incidentID),
+		}
+		if result.LogTruncated {
+			result.Summary += fmt.Sprintf("; only the first %d log entries were read, so later notifications are missing", models.MaxResults)
+		}
+
+		data, _ := json.Marshal(result)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func listIncidentResponderRequestsHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+		state, hasState := getString(args, "state")
+		if hasState && state != models.ResponderStatePending && state != models.ResponderStateJoined && state != models.ResponderStateDeclined {
+			return mcp.NewToolResultError("state must be one of: pending, joined, declined"), nil
+		}
+
+		// Responder request state is only exposed on the incident itself
+		var resp models.IncidentResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s", incidentID), nil, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		responders := []models.IncidentResponder{}
+		for _, responder := range resp.Incident.IncidentResponders {
+			if hasState && responder.State != state {
+				continue
+			}
+			responders = append(responders, responder)
+		}
+
+		result := models.ListResponse[models.IncidentResponder]{Response: responders}
+		return listResult(result), nil
+	}
+}
+
+// logEntryIncludes are the include[] values get_log_entry accepts
+var logEntryIncludes = []string{"channels", "incidents"}
+
+func getLogEntryHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		logEntryID, ok := getString(args, "log_entry_id")
+		if !ok {
+			return mcp.NewToolResultError("log_entry_id is required"), nil
+		}
+
+		params := make(map[string][]string)
+		if v, ok := getStringArray(args, "include"); ok {
+			for _, include := range v {
+				if !slices.Contains(logEntryIncludes, include) {
+					return mcp.NewToolResultError(fmt.Sprintf("include must be one of: %s", strings.Join(logEntryIncludes, ", "))), nil
+				}
+			}
+			params["include[]"] = v
+		}
+		loc, hasTimeZone, err := getTimeZone(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if hasTimeZone {
+			params["time_zone"] = []string{loc.String()}
+		}
+
+		var resp models.LogEntryResponse
+		if err := c.GetJSONWithArrayParamsContext(ctx, fmt.Sprintf("/log_entries/%s", logEntryID), params, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		entry := resp.LogEntry
+		if hasTimeZone {
+			entry.CreatedAt = entry.CreatedAt.InLocation(loc)
+		}
+
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return toolError(err), nil
+		}
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func listLogEntriesHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		var query models.LogEntryQuery
+
+		if v, ok, err := getDateTime(args, "since"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Since = v
+		}
+		if v, ok, err := getDateTime(args, "until"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Until = v
+		}
+		if v, ok := getStringArray(args, "team_ids"); ok {
+			query.TeamIDs = v
+		}
+		if v, ok := getBool(args, "is_overview"); ok {
+			query.IsOverview = &v
+		}
+		loc, hasTimeZone, err := getTimeZone(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if hasTimeZone {
+			query.TimeZone = loc.String()
+		}
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Limit = v
+		}
+		if v, ok, err := getInteger(args, "offset", 0, math.MaxInt); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Offset = v
+		}
+
+		var resp models.LogEntriesResponse
+		if err := c.GetJSONWithArrayParamsContext(ctx, "/log_entries", query.ToArrayParams(), &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		if hasTimeZone {
+			for i := range resp.LogEntries {
+				resp.LogEntries[i].CreatedAt = resp.LogEntries[i].CreatedAt.InLocation(loc)
+			}
+		}
+
+		result := models.ListResponse[models.LogEntry]{Response: resp.LogEntries}.WithPage(resp.Offset, resp.More, resp.Total)
+		return listResult(result), nil
+	}
+}
+
+func addIncidentSubscribersHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+
+		req, ok := subscribersRequestFromArgs(args)
+		if !ok {
+			return mcp.NewToolResultError("user_ids or team_ids is required"), nil
+		}
+
+		var resp models.IncidentSubscriptionsResponse
+		if err := c.PostJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/status_updates/subscribers", incidentID), req, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		result := models.ListResponse[models.IncidentSubscription]{Response: resp.Subscriptions}
+		return listResult(result), nil
+	}
+}
+
+func removeIncidentSubscribersHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		incidentID, ok := getString(args, "incident_id")
+		if !ok {
+			return mcp.NewToolResultError("incident_id is required"), nil
+		}
+
+		req, ok := subscribersRequestFromArgs(args)
+		if !ok {
+			return mcp.NewToolResultError("user_ids or team_ids is required"), nil
+		}
+
+		var resp models.IncidentUnsubscribeResponse
+		if err := c.PostJSONWithContext(ctx, fmt.Sprintf("/incidents/%s/status_updates/unsubscribe", incidentID), req, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+// subscribersRequestFromArgs builds a subscribers request from user_ids and team_ids arguments
+func subscribersRequestFromArgs(args map[string]any) (models.IncidentSubscribersRequest, bool) {
+	var req models.IncidentSubscribersRequest
+	if v, ok := getStringArray(args, "user_ids"); ok {
+		for _, id := range v {
+			req.Subscribers = append(req.Subscribers, models.IncidentSubscriberTarget{SubscriberID: id, SubscriberType: "user"})
+		}
+	}
+	if v, ok := getStringArray(args, "team_ids"); ok {
+		for _, id := range v {
+			req.Subscribers = append(req.Subscribers, models.IncidentSubscriberTarget{SubscriberID: id, SubscriberType: "team"})
+		}
+	}
+	return req, len(req.Subscribers) > 0
+}
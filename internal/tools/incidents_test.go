@@ -0,0 +1,880 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/auth"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// newTestClient starts a fake PagerDuty API and returns a client pointed at it
+func newTestClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return client.NewClient(client.Config{APIKey: "test-api-key", APIHost: ts.URL})
+}
+
+// newToolRequest builds a tool call request with the given arguments
+func newToolRequest(args map[string]any) mcp.CallToolRequest {
+	var request mcp.CallToolRequest
+	request.Params.Arguments = args
+	return request
+}
+
+// TestListIncidents_AssignedScope tests that request_scope 'assigned' filters by
+// the current user's ID and is intersected with explicit user_ids
+func TestListIncidents_AssignedScope(t *testing.T) {
+	var incidentsQuery url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/me":
+			w.Write([]byte(`{"user":{"id":"PME123","name":"Me","email":"me@example.com"}}`))
+		case "/incidents":
+			incidentsQuery = r.URL.Query()
+			w.Write([]byte(`{"incidents":[]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	result, err := listIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"request_scope": "assigned",
+		"user_ids":      "POTHER1,PME123",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	userIDs := incidentsQuery["user_ids[]"]
+	if len(userIDs) != 1 || userIDs[0] != "PME123" {
+		t.Errorf("Expected user_ids[] to be [PME123], got %v", userIDs)
+	}
+
+	incidentsQuery = nil
+	result, err = listIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"request_scope": "assigned",
+		"user_ids":      "POTHER1",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected user_ids without the current user to be rejected")
+	}
+	if incidentsQuery != nil {
+		t.Errorf("Expected no incidents request, got %v", incidentsQuery)
+	}
+}
+
+// TestListIncidents_TeamsScope tests that request_scope 'teams' filters by the
+// current user's teams, narrowed by any explicit team_ids
+func TestListIncidents_TeamsScope(t *testing.T) {
+	var incidentsQuery url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/me":
+			w.Write([]byte(`{"user":{"id":"PME123","teams":[{"id":"PTEAM1"},{"id":"PTEAM2"}]}}`))
+		case "/incidents":
+			incidentsQuery = r.URL.Query()
+			w.Write([]byte(`{"incidents":[]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	result, err := listIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"request_scope": "teams",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	teamIDs := incidentsQuery["team_ids[]"]
+	if len(teamIDs) != 2 || teamIDs[0] != "PTEAM1" || teamIDs[1] != "PTEAM2" {
+		t.Errorf("Expected team_ids[] to be [PTEAM1 PTEAM2], got %v", teamIDs)
+	}
+
+	result, err = listIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"request_scope": "teams",
+		"team_ids":      "PTEAM2,PTEAM9",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	teamIDs = incidentsQuery["team_ids[]"]
+	if len(teamIDs) != 1 || teamIDs[0] != "PTEAM2" {
+		t.Errorf("Expected team_ids[] to be narrowed to [PTEAM2], got %v", teamIDs)
+	}
+}
+
+// TestListIncidents_TimeZone tests that time_zone is validated locally and
+// forwarded by its canonical name
+func TestListIncidents_TimeZone(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"incidents":[]}`))
+	})
+
+	result, err := listIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{"time_zone": "Europe/Berlin"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if got := query.Get("time_zone"); got != "Europe/Berlin" {
+		t.Errorf("Expected time_zone=Europe/Berlin, got '%s'", got)
+	}
+
+	query = nil
+	result, err = listIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{"time_zone": "Mars/Olympus"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an unknown time_zone to be rejected")
+	}
+	if query != nil {
+		t.Errorf("Expected no API call, got %v", query)
+	}
+}
+
+// TestListIncidents_IncidentKey tests that incident_key is forwarded as a query filter
+func TestListIncidents_IncidentKey(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"incidents":[{"id":"PABC123","incident_key":"disk-full-web-01"}]}`))
+	})
+
+	result, err := listIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{"incident_key": "disk-full-web-01"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if got := query.Get("incident_key"); got != "disk-full-web-01" {
+		t.Errorf("Expected incident_key=disk-full-web-01, got '%s'", got)
+	}
+}
+
+// TestGetPastIncidents_Options tests that total and additional_details are forwarded and times are localized
+func TestGetPastIncidents_Options(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"past_incidents":[{"incident":{"id":"POLD1","created_at":"2024-01-15T15:00:00Z"},"score":0.92}],"total":7}`))
+	})
+
+	result, err := getPastIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"incident_id":        "PABC123",
+		"total":              true,
+		"additional_details": "incident",
+		"time_zone":          "Europe/Berlin",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if query.Get("total") != "true" || query.Get("additional_details[]") != "incident" {
+		t.Errorf("Expected total=true and additional_details[]=incident, got %v", query)
+	}
+
+	var resp models.PastIncidentsResponse
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &resp); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if resp.Total != 7 || len(resp.PastIncidents) != 1 || resp.PastIncidents[0].Score != 0.92 {
+		t.Fatalf("Expected one scored past incident and total 7, got %+v", resp)
+	}
+	if got := resp.PastIncidents[0].Incident.CreatedAt.String(); got != "2024-01-15T16:00:00+01:00" {
+		t.Errorf("Expected created_at in Berlin time, got %s", got)
+	}
+}
+
+// TestGetIncident_ByNumber tests that incident_number is looked up through the
+// incidents endpoint and that a different incident in the response is rejected
+func TestGetIncident_ByNumber(t *testing.T) {
+	var path string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"incident":{"id":"PABC123","incident_number":1234}}`))
+	})
+	handler := getIncidentHandler(c)
+
+	result, err := handler(context.Background(), newToolRequest(map[string]any{"incident_number": float64(1234)}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if path != "/incidents/1234" {
+		t.Errorf("Expected a lookup of /incidents/1234, got %s", path)
+	}
+	var incident models.Incident
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &incident); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if incident.ID != "PABC123" {
+		t.Errorf("Expected incident PABC123, got %+v", incident)
+	}
+
+	result, err = handler(context.Background(), newToolRequest(map[string]any{"incident_number": float64(99)}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected a response for a different incident number to be rejected")
+	}
+
+	for _, args := range []map[string]any{
+		{},
+		{"incident_id": "PABC123", "incident_number": float64(1234)},
+		{"incident_number": float64(0)},
+	} {
+		result, err := handler(context.Background(), newToolRequest(args))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("Expected an error result for %v", args)
+		}
+	}
+}
+
+// TestGetIncident_ContextToken tests that a per-request PagerDuty token in the context is used for the API call
+func TestGetIncident_ContextToken(t *testing.T) {
+	var authorization string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Write([]byte(`{"incident":{"id":"PABC123"}}`))
+	})
+
+	ctx := context.WithValue(context.Background(), auth.PagerDutyTokenKey, "tenant-token")
+	result, err := getIncidentHandler(c)(ctx, newToolRequest(map[string]any{"incident_id": "PABC123"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if authorization != "Token token=tenant-token" {
+		t.Errorf("Expected the context token to be used, got '%s'", authorization)
+	}
+}
+
+// TestGetIncident_TimeZone tests that time_zone re-renders the incident's timestamps in that zone
+func TestGetIncident_TimeZone(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"incident":{"id":"PABC123","created_at":"2024-01-15T15:00:00Z","updated_at":""}}`))
+	})
+
+	result, err := getIncidentHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"incident_id": "PABC123",
+		"time_zone":   "America/New_York",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out map[string]any
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if out["created_at"] != "2024-01-15T10:00:00-05:00" {
+		t.Errorf("Expected created_at in New York time, got %v", out["created_at"])
+	}
+	if _, ok := out["updated_at"]; ok {
+		t.Errorf("Expected unset updated_at to stay omitted, got %v", out["updated_at"])
+	}
+}
+
+// TestGetIncident_InvalidTimeZone tests that an unknown time_zone is rejected before calling the API
+func TestGetIncident_InvalidTimeZone(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no API call, got %s %s", r.Method, r.URL.Path)
+	})
+
+	result, err := getIncidentHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"incident_id": "PABC123",
+		"time_zone":   "Mars/Olympus_Mons",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result for an invalid time zone")
+	}
+}
+
+// TestGetIncidentAlertSummary tests that alert counts are returned with the incident's triage fields
+func TestGetIncidentAlertSummary(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incidents/PABC123" {
+			t.Errorf("Expected GET /incidents/PABC123, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"incident":{"id":"PABC123","incident_number":42,"title":"Disk full","status":"triggered","urgency":"high","alert_counts":{"all":5,"triggered":3,"resolved":2}}}`))
+	})
+
+	result, err := getIncidentAlertSummaryHandler(c)(context.Background(), newToolRequest(map[string]any{"incident_id": "PABC123"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var summary models.IncidentAlertSummary
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &summary); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	want := models.IncidentAlertSummary{
+		IncidentID:     "PABC123",
+		IncidentNumber: 42,
+		Title:          "Disk full",
+		Status:         "triggered",
+		Urgency:        "high",
+		AlertCounts:    models.AlertCounts{All: 5, Triggered: 3, Resolved: 2},
+	}
+	if summary != want {
+		t.Errorf("Expected %+v, got %+v", want, summary)
+	}
+}
+
+// TestCreateIncident_DedupHit tests that dedup_check returns an open incident with the same key instead of creating one
+func TestCreateIncident_DedupHit(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected no incident to be created, got %s %s", r.Method, r.URL.Path)
+		}
+		query = r.URL.Query()
+		w.Write([]byte(`{"incidents":[{"id":"PEXIST1","incident_key":"disk-full"}]}`))
+	})
+
+	result, err := createIncidentHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"title":        "Disk full",
+		"service_id":   "PSVC1",
+		"incident_key": "disk-full",
+		"dedup_check":  true,
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var out struct {
+		Deduplicated bool `json:"deduplicated"`
+		Incident     struct {
+			ID string `json:"id"`
+		} `json:"incident"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if !out.Deduplicated || out.Incident.ID != "PEXIST1" {
+		t.Errorf("Expected dedup hit on PEXIST1, got %+v", out)
+	}
+	if query.Get("incident_key") != "disk-full" || query.Get("service_ids[]") != "PSVC1" || len(query["statuses[]"]) != 2 {
+		t.Errorf("Expected lookup by key, service, and open statuses, got %v", query)
+	}
+}
+
+// TestCreateIncident_ConferenceBridge tests that conference details and priority are sent in the create request
+func TestCreateIncident_ConferenceBridge(t *testing.T) {
+	var body struct {
+		Incident struct {
+			ConferenceBridge map[string]string `json:"conference_bridge"`
+			Priority         map[string]string `json:"priority"`
+		} `json:"incident"`
+	}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to parse request body: %v", err)
+		}
+		w.Write([]byte(`{"incident":{"id":"PNEW1"}}`))
+	})
+
+	result, err := createIncidentHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"title":             "Checkout errors",
+		"service_id":        "PSVC1",
+		"conference_number": "+1-555-123-4567",
+		"conference_url":    "https://meet.example.com/checkout",
+		"priority_id":       "PPRIO1",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	bridge := body.Incident.ConferenceBridge
+	if bridge["conference_number"] != "+1-555-123-4567" || bridge["conference_url"] != "https://meet.example.com/checkout" {
+		t.Errorf("Expected conference bridge in request body, got %v", bridge)
+	}
+	if priority := body.Incident.Priority; priority["id"] != "PPRIO1" || priority["type"] != "priority_reference" {
+		t.Errorf("Expected priority reference in request body, got %v", priority)
+	}
+}
+
+// TestCreateIncident_InvalidConferenceURL tests that a malformed conference URL is rejected
+func TestCreateIncident_InvalidConferenceURL(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no API call, got %s %s", r.Method, r.URL.Path)
+	})
+
+	result, _ := createIncidentHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"title":          "Checkout errors",
+		"service_id":     "PSVC1",
+		"conference_url": "meet.example.com",
+	}))
+	if !result.IsError {
+		t.Error("Expected IsError to be true")
+	}
+}
+
+// TestCreateIncident_Assignee tests that assignee_id is sent as a user_reference assignment and malformed IDs are rejected
+func TestCreateIncident_Assignee(t *testing.T) {
+	var body struct {
+		Incident struct {
+			Assignments []struct {
+				At       string            `json:"at"`
+				Assignee map[string]string `json:"assignee"`
+			} `json:"assignments"`
+		} `json:"incident"`
+	}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to parse request body: %v", err)
+		}
+		w.Write([]byte(`{"incident":{"id":"PNEW1"}}`))
+	})
+
+	handler := createIncidentHandler(c)
+	result, _ := handler(context.Background(), newToolRequest(map[string]any{
+		"title":       "Checkout errors",
+		"service_id":  "PSVC1",
+		"assignee_id": "PUSER1",
+	}))
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	assignments := body.Incident.Assignments
+	if len(assignments) != 1 || assignments[0].Assignee["id"] != "PUSER1" || assignments[0].Assignee["type"] != "user_reference" || assignments[0].At == "" {
+		t.Errorf("Expected a single user_reference assignment, got %+v", assignments)
+	}
+
+	result, _ = handler(context.Background(), newToolRequest(map[string]any{
+		"title":       "Checkout errors",
+		"service_id":  "PSVC1",
+		"assignee_id": "alice@example.com",
+	}))
+	if !result.IsError {
+		t.Error("Expected an email address to be rejected as assignee_id")
+	}
+}
+
+// TestListIncidentNotifications tests that only notify log entries are returned, flattened to channel and status
+func TestListIncidentNotifications(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incidents/PINC1/log_entries" {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("is_overview"); got != "false" {
+			t.Errorf("Expected is_overview=false, got '%s'", got)
+		}
+		w.Write([]byte(`{"log_entries":[
+			{"id":"L1","type":"trigger_log_entry","created_at":"2024-01-15T10:00:00Z"},
+			{"id":"L2","type":"notify_log_entry","created_at":"2024-01-15T10:00:05Z","user":{"id":"PUSER1"},
+			 "notification":{"type":"sms_notification","status":"success","address":"+15555550100"}}
+		],"more":false}`))
+	})
+
+	result, err := listIncidentNotificationsHandler(c)(context.Background(), newToolRequest(map[string]any{"incident_id": "PINC1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out models.IncidentNotifications
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Notifications) != 1 {
+		t.Fatalf("Expected 1 notification, got %d", len(out.Notifications))
+	}
+	n := out.Notifications[0]
+	if n.Channel != "sms" || n.Status != "success" || n.User == nil || n.User.ID != "PUSER1" || n.LogEntryID != "L2" {
+		t.Errorf("Unexpected notification %+v", n)
+	}
+	if out.LogTruncated {
+		t.Error("Expected a complete log not to be reported as truncated")
+	}
+}
+
+// TestListIncidentNotifications_Truncated tests that a log longer than the
+// scan limit is reported as truncated
+func TestListIncidentNotifications_Truncated(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		entries := make([]string, 100)
+		for i := range entries {
+			entries[i] = fmt.Sprintf(`{"id":"L%d","type":"notify_log_entry","notification":{"type":"email_notification","status":"success"}}`, offset+i)
+		}
+		fmt.Fprintf(w, `{"log_entries":[%s],"more":true}`, strings.Join(entries, ","))
+	})
+
+	result, err := listIncidentNotificationsHandler(c)(context.Background(), newToolRequest(map[string]any{"incident_id": "PINC1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var out models.IncidentNotifications
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if !out.LogTruncated || len(out.Notifications) != models.MaxResults || !strings.Contains(out.Summary, "missing") {
+		t.Errorf("Expected a truncated scan of %d entries, got %d notifications, truncated=%v, summary %q", models.MaxResults, len(out.Notifications), out.LogTruncated, out.Summary)
+	}
+}
+
+// TestUpdateIncidentNote tests that the new content is PUT to the note with the caller's From header
+func TestUpdateIncidentNote(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/incidents/PINC1/notes/PNOTE1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("From"); got != "alice@example.com" {
+			t.Errorf("Expected From alice@example.com, got '%s'", got)
+		}
+		var body models.IncidentNoteUpdateRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		if body.Note.Content != "Rolled back deploy 1.4.2" {
+			t.Errorf("Unexpected note content '%s'", body.Note.Content)
+		}
+		w.Write([]byte(`{"note":{"id":"PNOTE1","content":"Rolled back deploy 1.4.2"}}`))
+	})
+
+	result, err := updateIncidentNoteHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"incident_id": "PINC1",
+		"note_id":     "PNOTE1",
+		"note":        "Rolled back deploy 1.4.2",
+		"from_email":  "alice@example.com",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var note models.IncidentNote
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &note); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if note.ID != "PNOTE1" {
+		t.Errorf("Expected note PNOTE1, got %+v", note)
+	}
+}
+
+// TestUpdateIncidentNote_RequestIdentity tests that the request's From identity
+// is used and a from_email naming someone else is rejected
+func TestUpdateIncidentNote_RequestIdentity(t *testing.T) {
+	var from string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		from = r.Header.Get("From")
+		w.Write([]byte(`{"note":{"id":"PNOTE1"}}`))
+	})
+	ctx := auth.WithFromEmail(context.Background(), "alice@example.com")
+
+	result, err := updateIncidentNoteHandler(c)(ctx, newToolRequest(map[string]any{
+		"incident_id": "PINC1",
+		"note_id":     "PNOTE1",
+		"note":        "Rolled back deploy 1.4.2",
+		"from_email":  "mallory@example.com",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected a from_email that differs from the request identity to be rejected")
+	}
+	if from != "" {
+		t.Errorf("Expected no API call, got one from '%s'", from)
+	}
+
+	result, err = updateIncidentNoteHandler(c)(ctx, newToolRequest(map[string]any{
+		"incident_id": "PINC1",
+		"note_id":     "PNOTE1",
+		"note":        "Rolled back deploy 1.4.2",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if from != "alice@example.com" {
+		t.Errorf("Expected From alice@example.com, got '%s'", from)
+	}
+}
+
+// TestGetLogEntry tests that includes are forwarded and channel detail is returned in full
+func TestGetLogEntry(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/log_entries/L1" {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+		if got := r.URL.Query()["include[]"]; len(got) != 1 || got[0] != "channels" {
+			t.Errorf("Expected include[]=channels, got %v", got)
+		}
+		w.Write([]byte(`{"log_entry":{"id":"L1","type":"trigger_log_entry","created_at":"2024-01-15T10:00:00Z",
+			"channel":{"type":"email","subject":"Disk full on db-1","body":"Usage at 98%"}}}`))
+	})
+
+	result, err := getLogEntryHandler(c)(context.Background(), newToolRequest(map[string]any{"log_entry_id": "L1", "include": "channels"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var entry models.LogEntry
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &entry); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if entry.Channel == nil || entry.Channel.Type != "email" || entry.Channel.Subject != "Disk full on db-1" {
+		t.Errorf("Unexpected channel %+v", entry.Channel)
+	}
+
+	result, err = getLogEntryHandler(c)(context.Background(), newToolRequest(map[string]any{"log_entry_id": "L1", "include": "users"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an unsupported include to be rejected")
+	}
+}
+
+// TestListLogEntries tests that filters are forwarded to /log_entries and paging metadata is returned
+func TestListLogEntries(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/log_entries" {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+		query = r.URL.Query()
+		w.Write([]byte(`{"log_entries":[{"id":"L1","type":"trigger_log_entry"},{"id":"L2","type":"acknowledge_log_entry"}],"offset":0,"more":true}`))
+	})
+
+	result, err := listLogEntriesHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"since":       "2024-01-15T09:00:00Z",
+		"until":       "2024-01-15T10:00:00Z",
+		"team_ids":    "PTEAM1,PTEAM2",
+		"is_overview": true,
+		"limit":       float64(2),
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if query.Get("since") != "2024-01-15T09:00:00Z" || query.Get("until") != "2024-01-15T10:00:00Z" {
+		t.Errorf("Expected since/until to be forwarded, got %v", query)
+	}
+	if got := query["team_ids[]"]; len(got) != 2 || got[0] != "PTEAM1" || got[1] != "PTEAM2" {
+		t.Errorf("Expected team_ids[]=[PTEAM1 PTEAM2], got %v", got)
+	}
+	if query.Get("is_overview") != "true" || query.Get("limit") != "2" {
+		t.Errorf("Expected is_overview=true and limit=2, got %v", query)
+	}
+
+	var out models.ListResponse[models.LogEntry]
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Response) != 2 || !out.More || out.NextOffset != 2 {
+		t.Errorf("Expected 2 entries with next_offset 2, got %+v", out)
+	}
+}
+
+// TestListIncidentResponderRequests tests that responder state is read from the incident and filtered
+func TestListIncidentResponderRequests(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incidents/PINC1" {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"incident":{"id":"PINC1","incidents_responders":[
+			{"state":"pending","user":{"id":"PUSER1"},"requester":{"id":"PUSER9"},"message":"Need a DBA"},
+			{"state":"joined","user":{"id":"PUSER2"},"requester":{"id":"PUSER9"}}
+		]}}`))
+	})
+
+	result, err := listIncidentResponderRequestsHandler(c)(context.Background(), newToolRequest(map[string]any{"incident_id": "PINC1", "state": "pending"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out models.ListResponse[models.IncidentResponder]
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Response) != 1 {
+		t.Fatalf("Expected 1 pending responder, got %d", len(out.Response))
+	}
+	if r := out.Response[0]; r.User.ID != "PUSER1" || r.Requester == nil || r.Requester.ID != "PUSER9" || r.Message != "Need a DBA" {
+		t.Errorf("Unexpected responder %+v", r)
+	}
+}
+
+// TestAddResponders_Requester tests that the requester is resolved from the current user when not given
+func TestAddResponders_Requester(t *testing.T) {
+	var body models.IncidentResponderRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/me":
+			w.Write([]byte(`{"user":{"id":"PUSER9","email":"alice@example.com"}}`))
+		case "/incidents/PINC1/responder_requests":
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode body: %v", err)
+			}
+			w.Write([]byte(`{"responder_request":{}}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	result, err := addRespondersHandler(c)(context.Background(), newToolRequest(map[string]any{"incident_id": "PINC1", "responder_ids": "PUSER1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if body.RequesterID != "PUSER9" {
+		t.Errorf("Expected requester_id PUSER9, got '%s'", body.RequesterID)
+	}
+	if len(body.Targets) != 1 || body.Targets[0].ID != "PUSER1" {
+		t.Errorf("Unexpected targets %+v", body.Targets)
+	}
+
+	result, err = addRespondersHandler(c)(context.Background(), newToolRequest(map[string]any{"incident_id": "PINC1", "responder_ids": "PUSER1", "requester_id": "PUSER5"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if body.RequesterID != "PUSER5" {
+		t.Errorf("Expected requester_id PUSER5, got '%s'", body.RequesterID)
+	}
+}
+
+// TestResolveIncident_Resolution tests that the resolution note is posted
+// before the incident is resolved, and that a failed resolve reports the note
+func TestResolveIncident_Resolution(t *testing.T) {
+	var calls []string
+	resolveStatus := http.StatusOK
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case http.MethodPost:
+			var body models.IncidentNoteCreateRequest
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("Failed to decode note: %v", err)
+			}
+			if body.Note.Content != "Rolled back deploy 1.4.2" {
+				t.Errorf("Unexpected note content '%s'", body.Note.Content)
+			}
+			w.Write([]byte(`{"note":{"id":"PNOTE1","content":"Rolled back deploy 1.4.2"}}`))
+		case http.MethodPut:
+			w.WriteHeader(resolveStatus)
+			w.Write([]byte(`{"incidents":[{"id":"PINC1","status":"resolved"}]}`))
+		}
+	})
+	args := map[string]any{"incident_id": "PINC1", "resolution": "Rolled back deploy 1.4.2"}
+
+	result, err := resolveIncidentHandler(c)(context.Background(), newToolRequest(args))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if len(calls) != 2 || calls[0] != "POST /incidents/PINC1/notes" || calls[1] != "PUT /incidents" {
+		t.Errorf("Expected the note to be posted before resolving, got %v", calls)
+	}
+
+	resolveStatus = http.StatusForbidden
+	result, err = resolveIncidentHandler(c)(context.Background(), newToolRequest(args))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Fatal("Expected a failed resolve to be reported")
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "PNOTE1") || !strings.Contains(text, "note") {
+		t.Errorf("Expected the error to mention the posted note, got %s", text)
+	}
+}
+
+// TestSubscribersRequestFromArgs tests that user and team IDs are read from
+// comma-separated strings and JSON arrays alike
+func TestSubscribersRequestFromArgs(t *testing.T) {
+	req, ok := subscribersRequestFromArgs(map[string]any{
+		"user_ids": []any{"PUSER1", " PUSER2 "},
+		"team_ids": "PTEAM1",
+	})
+	if !ok {
+		t.Fatal("Expected subscribers to be found")
+	}
+	want := []models.IncidentSubscriberTarget{
+		{SubscriberID: "PUSER1", SubscriberType: "user"},
+		{SubscriberID: "PUSER2", SubscriberType: "user"},
+		{SubscriberID: "PTEAM1", SubscriberType: "team"},
+	}
+	if len(req.Subscribers) != len(want) {
+		t.Fatalf("Expected %d subscribers, got %+v", len(want), req.Subscribers)
+	}
+	for i, s := range req.Subscribers {
+		if s != want[i] {
+			t.Errorf("Expected subscriber %d to be %+v, got %+v", i, want[i], s)
+		}
+	}
+}
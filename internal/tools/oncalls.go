@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"sort"
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
@@ -18,54 +20,264 @@ func RegisterOncallReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithDescription("List current and upcoming on-call entries. Returns who is on-call right now or during a specified time range. Use 'earliest=true' to get just the current on-call person for each schedule. This is the primary tool for finding who to contact for an incident."),
 		mcp.WithTitleAnnotation("List On-Calls"),
 		mcp.WithReadOnlyHintAnnotation(true),
-		mcp.WithString("time_zone", mcp.Description("IANA time zone for returned times (e.g., 'America/New_York', 'UTC')")),
+		mcp.WithString("time_zone", mcp.Description(timeZoneDescription)),
 		mcp.WithString("since", mcp.Description("Start of date range in ISO 8601 format (e.g., '2024-01-15T00:00:00Z'). Defaults to now.")),
 		mcp.WithString("until", mcp.Description("End of date range in ISO 8601 format (e.g., '2024-01-22T00:00:00Z'). Defaults to now.")),
+		mcp.WithString("at", mcp.Description("Return who is on call at exactly this instant, in ISO 8601 format (e.g., '2024-01-19T14:00:00Z'). Cannot be combined with since or until.")),
 		mcp.WithBoolean("earliest", mcp.Description("If true, return only the earliest/current on-call entry for each schedule. Useful for finding who is on-call right now.")),
 		mcp.WithString("schedule_ids", mcp.Description("Filter by schedules. Comma-separated schedule IDs (e.g., 'PSCHED1,PSCHED2')")),
 		mcp.WithString("user_ids", mcp.Description("Filter by users. Comma-separated user IDs (e.g., 'PUSER1,PUSER2')")),
 		mcp.WithString("escalation_policy_ids", mcp.Description("Filter by escalation policies. Comma-separated policy IDs (e.g., 'PESCPOL1,PESCPOL2')")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
 	), listOncallsHandler(c))
+
+	// who_is_oncall
+	s.AddTool(mcp.NewTool("who_is_oncall",
+		mcp.WithDescription("Find who is on-call right now for an escalation policy or service. Returns a simplified list of user name, escalation level, and schedule; an empty list means no one is on call. Provide escalation_policy_id or service_id."),
+		mcp.WithTitleAnnotation("Who Is On-Call"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("escalation_policy_id", mcp.Description("The unique escalation policy ID (e.g., 'PESCPOL123')")),
+		mcp.WithString("service_id", mcp.Description("The unique service ID; its escalation policy is used (e.g., 'PDSVC123')")),
+	), whoIsOncallHandler(c))
+
+	// get_oncall_workload
+	s.AddTool(mcp.NewTool("get_oncall_workload",
+		mcp.WithDescription("Show what the current on-call people are dealing with: resolves who is on call right now for a schedule or escalation policy, then lists the open (triggered or acknowledged) incidents assigned to each of them. Reads up to 100 open incidents. Provide schedule_id or escalation_policy_id."),
+		mcp.WithTitleAnnotation("Get On-Call Workload"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("schedule_id", mcp.Description("The unique schedule ID (e.g., 'PSCHED123')")),
+		mcp.WithString("escalation_policy_id", mcp.Description("The unique escalation policy ID (e.g., 'PESCPOL123')")),
+	), getOncallWorkloadHandler(c))
 }
 
 func listOncallsHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
-		params := make(map[string]string)
+		params := make(map[string][]string)
 
-		if v, ok := getString(args, "time_zone"); ok {
-			params["time_zone"] = v
+		loc, hasZone, err := getTimeZone(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if hasZone {
+			params["time_zone"] = []string{loc.String()}
 		}
-		if v, ok := getString(args, "since"); ok {
-			params["since"] = v
+		if v, ok, err := getDateTime(args, "since"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["since"] = []string{v}
 		}
-		if v, ok := getString(args, "until"); ok {
-			params["until"] = v
+		if v, ok, err := getDateTime(args, "until"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["until"] = []string{v}
+		}
+		// A zero-length range returns the on-call entries active at that instant
+		if v, ok, err := getDateTime(args, "at"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			if len(params["since"]) > 0 || len(params["until"]) > 0 {
+				return mcp.NewToolResultError("at cannot be combined with since or until"), nil
+			}
+			params["since"] = []string{v}
+			params["until"] = []string{v}
 		}
 		if v, ok := getBool(args, "earliest"); ok && v {
-			params["earliest"] = "true"
+			params["earliest"] = []string{"true"}
 		}
-		if v, ok := getString(args, "schedule_ids"); ok {
+		if v, ok := getStringArray(args, "schedule_ids"); ok {
 			params["schedule_ids[]"] = v
 		}
-		if v, ok := getString(args, "user_ids"); ok {
+		if v, ok := getStringArray(args, "user_ids"); ok {
 			params["user_ids[]"] = v
 		}
-		if v, ok := getString(args, "escalation_policy_ids"); ok {
+		if v, ok := getStringArray(args, "escalation_policy_ids"); ok {
 			params["escalation_policy_ids[]"] = v
 		}
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = []string{fmt.Sprintf("%d", v)}
 		}
 
 		var resp models.OncallsResponse
-		if err := c.GetJSON("/oncalls", params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithArrayParamsContext(ctx, "/oncalls", params, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		if hasZone {
+			localizeOncalls(resp.Oncalls, loc)
+		}
+
+		result := models.ListResponse[models.Oncall]{Response: resp.Oncalls}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
+	}
+}
+
+func whoIsOncallHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		policyID, hasPolicy := getString(args, "escalation_policy_id")
+		serviceID, hasService := getString(args, "service_id")
+		if !hasPolicy && !hasService {
+			return mcp.NewToolResultError("escalation_policy_id or service_id is required"), nil
+		}
+
+		// Resolve the service's escalation policy if needed
+		if !hasPolicy {
+			var svcResp models.ServiceResponse
+			if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/services/%s", serviceID), nil, &svcResp); err != nil {
+				return toolError(err), nil
+			}
+			if svcResp.Service.EscalationPolicy == nil || svcResp.Service.EscalationPolicy.ID == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("service %s has no escalation policy", serviceID)), nil
+			}
+			policyID = svcResp.Service.EscalationPolicy.ID
+		}
+
+		params := map[string]string{
+			"escalation_policy_ids[]": policyID,
+			"earliest":                "true",
+		}
+
+		var resp models.OncallsResponse
+		if err := c.GetJSONWithContext(ctx, "/oncalls", params, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		summaries := make([]models.OncallSummary, len(resp.Oncalls))
+		for i, oc := range resp.Oncalls {
+			summaries[i] = oncallSummary(oc)
 		}
 
-		result := models.ListResponse[models.Oncall]{Response: resp.Oncalls}
-		data, _ := json.Marshal(result)
+		result := models.ListResponse[models.OncallSummary]{Response: summaries}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
+	}
+}
+
+// maxWorkloadOncalls and maxWorkloadIncidents bound the on-call entries and
+// open incidents read by get_oncall_workload
+const (
+	maxWorkloadOncalls   = 100
+	maxWorkloadIncidents = 100
+)
+
+func getOncallWorkloadHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		scheduleID, hasSchedule := getString(args, "schedule_id")
+		policyID, hasPolicy := getString(args, "escalation_policy_id")
+		if hasSchedule == hasPolicy {
+			return mcp.NewToolResultError("provide exactly one of schedule_id or escalation_policy_id"), nil
+		}
+
+		params := map[string]string{
+			"earliest": "true",
+			"limit":    fmt.Sprintf("%d", maxWorkloadOncalls),
+		}
+		if hasSchedule {
+			params["schedule_ids[]"] = scheduleID
+		} else {
+			params["escalation_policy_ids[]"] = policyID
+		}
+		var oncallResp models.OncallsResponse
+		if err := c.GetJSONWithContext(ctx, "/oncalls", params, &oncallResp); err != nil {
+			return toolError(fmt.Errorf("failed to get on-calls: %w", err)), nil
+		}
+
+		// A user on call at several levels, or through several policies that
+		// share the schedule, is listed once
+		workload := models.OncallWorkload{
+			Responders:       []models.ResponderWorkload{},
+			OncallsTruncated: oncallResp.More,
+		}
+		index := make(map[string]int)
+		for _, oc := range oncallResp.Oncalls {
+			if oc.User.ID == "" {
+				continue
+			}
+			i, ok := index[oc.User.ID]
+			if !ok {
+				i = len(workload.Responders)
+				index[oc.User.ID] = i
+				workload.Responders = append(workload.Responders, models.ResponderWorkload{
+					User:             oc.User,
+					EscalationLevels: []int{},
+					Incidents:        []models.OncallWorkloadIncident{},
+				})
+			}
+			if !slices.Contains(workload.Responders[i].EscalationLevels, oc.EscalationLevel) {
+				workload.Responders[i].EscalationLevels = append(workload.Responders[i].EscalationLevels, oc.EscalationLevel)
+			}
+		}
+		if len(workload.Responders) == 0 {
+			workload.Summary = "No one is currently on call"
+			data, _ := json.Marshal(workload)
+			return mcp.NewToolResultText(string(data)), nil
+		}
+
+		userIDs := make([]string, len(workload.Responders))
+		for i, responder := range workload.Responders {
+			userIDs[i] = responder.User.ID
+			sort.Ints(workload.Responders[i].EscalationLevels)
+		}
+		incidentParams := map[string][]string{
+			"user_ids[]": userIDs,
+			"statuses[]": {"triggered", "acknowledged"},
+			"sort_by":    {"created_at:desc"},
+			"limit":      {fmt.Sprintf("%d", maxWorkloadIncidents)},
+		}
+		var incidentResp models.IncidentsResponse
+		if err := c.GetJSONWithArrayParamsContext(ctx, "/incidents", incidentParams, &incidentResp); err != nil {
+			return toolError(fmt.Errorf("failed to get incidents: %w", err)), nil
+		}
+		workload.IncidentsTruncated = incidentResp.More
+
+		// An incident assigned to several on-call users appears under each of them
+		count := 0
+		for _, incident := range incidentResp.Incidents {
+			entry := models.OncallWorkloadIncident{
+				ID:             incident.ID,
+				IncidentNumber: incident.IncidentNumber,
+				Title:          incident.Title,
+				Status:         incident.Status,
+				Urgency:        incident.Urgency,
+				CreatedAt:      incident.CreatedAt,
+				HTMLURL:        incident.HTMLURL,
+			}
+			matched := false
+			for _, assignment := range incident.Assignments {
+				if i, ok := index[assignment.Assignee.ID]; ok {
+					workload.Responders[i].Incidents = append(workload.Responders[i].Incidents, entry)
+					matched = true
+				}
+			}
+			if matched {
+				count++
+			}
+		}
+
+		workload.Summary = fmt.Sprintf("%d open incidents assigned across %d on-call users", count, len(workload.Responders))
+		if workload.OncallsTruncated {
+			workload.Summary += fmt.Sprintf("; only the first %d on-call entries were read", maxWorkloadOncalls)
+		}
+		if workload.IncidentsTruncated {
+			workload.Summary += fmt.Sprintf("; only the first %d open incidents were read", maxWorkloadIncidents)
+		}
+		data, _ := json.Marshal(workload)
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
+
+// oncallSummary returns the simplified view of an on-call entry
+func oncallSummary(oc models.Oncall) models.OncallSummary {
+	summary := models.OncallSummary{
+		UserID:          oc.User.ID,
+		UserName:        oc.User.Summary,
+		EscalationLevel: oc.EscalationLevel,
+	}
+	if oc.Schedule != nil {
+		summary.ScheduleName = oc.Schedule.Summary
+	}
+	return summary
+}
@@ -0,0 +1,169 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestListOncalls_At tests that at sets since and until to the same instant
+// and is rejected alongside an explicit range
+func TestListOncalls_At(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"oncalls":[]}`))
+	})
+	handler := listOncallsHandler(c)
+
+	result, err := handler(context.Background(), newToolRequest(map[string]any{
+		"at": "2024-01-19T14:00:00Z",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if query.Get("since") != "2024-01-19T14:00:00Z" || query.Get("until") != "2024-01-19T14:00:00Z" {
+		t.Errorf("Expected since and until at the instant, got %v", query)
+	}
+
+	for _, args := range []map[string]any{
+		{"at": "2024-01-19T14:00:00Z", "since": "2024-01-19T00:00:00Z"},
+		{"at": "Friday at 2pm"},
+	} {
+		result, err := handler(context.Background(), newToolRequest(args))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("Expected an error result for %v", args)
+		}
+	}
+}
+
+// TestWhoIsOncall tests that a service's escalation policy is resolved and
+// that an empty result is still a JSON list
+func TestWhoIsOncall(t *testing.T) {
+	oncalls := `{"oncalls":[{"escalation_level":1,"user":{"id":"PUSER1","summary":"Alice"},"schedule":{"id":"PSCHED1","summary":"Primary"}}]}`
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/services/PSVC1":
+			w.Write([]byte(`{"service":{"id":"PSVC1","escalation_policy":{"id":"PEP1"}}}`))
+		case "/oncalls":
+			query = r.URL.Query()
+			w.Write([]byte(oncalls))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+	handler := whoIsOncallHandler(c)
+
+	result, err := handler(context.Background(), newToolRequest(map[string]any{"service_id": "PSVC1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if query.Get("escalation_policy_ids[]") != "PEP1" || query.Get("earliest") != "true" {
+		t.Errorf("Expected the service's policy PEP1 with earliest=true, got %v", query)
+	}
+	var out models.ListResponse[models.OncallSummary]
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Response) != 1 || out.Response[0].UserName != "Alice" || out.Response[0].ScheduleName != "Primary" {
+		t.Errorf("Expected Alice at level 1, got %+v", out.Response)
+	}
+
+	oncalls = `{"oncalls":[]}`
+	result, err = handler(context.Background(), newToolRequest(map[string]any{"escalation_policy_id": "PEP1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	var empty struct {
+		Response []models.OncallSummary `json:"response"`
+		Summary  string                 `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &empty); err != nil {
+		t.Fatalf("Expected a JSON result when no one is on call: %v", err)
+	}
+	if empty.Response == nil || len(empty.Response) != 0 || empty.Summary == "" {
+		t.Errorf("Expected an empty list with a summary, got %+v", empty)
+	}
+}
+
+// TestGetOncallWorkload tests that open incidents are grouped under the
+// current on-call users they are assigned to
+func TestGetOncallWorkload(t *testing.T) {
+	var incidentQuery url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oncalls":
+			q := r.URL.Query()
+			if q.Get("schedule_ids[]") != "PSCHED1" || q.Get("earliest") != "true" || q.Get("limit") != "100" {
+				t.Errorf("Unexpected on-call query %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"oncalls":[
+				{"escalation_level":1,"user":{"id":"PUSER1","summary":"Alice"}},
+				{"escalation_level":2,"user":{"id":"PUSER1","summary":"Alice"}},
+				{"escalation_level":1,"user":{"id":"PUSER2","summary":"Bob"}}
+			],"more":true}`))
+		case "/incidents":
+			incidentQuery = r.URL.Query()
+			w.Write([]byte(`{"incidents":[
+				{"id":"PINC1","status":"triggered","assignments":[{"assignee":{"id":"PUSER1"}}]},
+				{"id":"PINC2","status":"acknowledged","assignments":[{"assignee":{"id":"PUSER1"}},{"assignee":{"id":"PUSER2"}}]},
+				{"id":"PINC3","status":"triggered","assignments":[{"assignee":{"id":"PUSER9"}}]}
+			],"more":true}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	result, err := getOncallWorkloadHandler(c)(context.Background(), newToolRequest(map[string]any{"schedule_id": "PSCHED1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if ids := incidentQuery["user_ids[]"]; len(ids) != 2 || ids[0] != "PUSER1" || ids[1] != "PUSER2" {
+		t.Errorf("Expected user_ids[] [PUSER1 PUSER2], got %v", ids)
+	}
+	if statuses := incidentQuery["statuses[]"]; len(statuses) != 2 {
+		t.Errorf("Expected triggered and acknowledged statuses, got %v", statuses)
+	}
+
+	var out models.OncallWorkload
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Responders) != 2 {
+		t.Fatalf("Expected 2 responders, got %+v", out.Responders)
+	}
+	alice, bob := out.Responders[0], out.Responders[1]
+	if len(alice.EscalationLevels) != 2 || len(alice.Incidents) != 2 || alice.Incidents[1].ID != "PINC2" {
+		t.Errorf("Unexpected workload for Alice: %+v", alice)
+	}
+	if len(bob.Incidents) != 1 || bob.Incidents[0].ID != "PINC2" {
+		t.Errorf("Unexpected workload for Bob: %+v", bob)
+	}
+	if !out.OncallsTruncated || !out.IncidentsTruncated {
+		t.Errorf("Expected the on-calls and incidents to be truncated, got %+v", out)
+	}
+	if out.Summary != "2 open incidents assigned across 2 on-call users; only the first 100 on-call entries were read; only the first 100 open incidents were read" {
+		t.Errorf("Unexpected summary %q", out.Summary)
+	}
+}
@@ -0,0 +1,328 @@
+package tools
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+)
+
+// PagerDuty has no API for dry-running an event through an orchestration, so
+// simulate_event_orchestration evaluates rules locally, and
+// validate_orchestration_condition checks expressions before they are saved.
+// This file implements the subset of the PagerDuty Condition Language (PCL)
+// needed for that:
+//
+//	<path> matches '<value>'        exact match
+//	<path> matches part '<value>'   substring match
+//	<path> matches regex '<value>'  RE2 regular expression
+//	<path> exists
+//	not <condition>, <a> and <b>, <a> or <b>, parentheses
+//
+// Paths start with event. or raw_event. and are resolved against the sample event.
+
+// pclToken is a lexical token in a condition expression
+type pclToken struct {
+	text   string
+	quoted bool
+}
+
+// tokenizePCL splits a condition expression into words, parentheses, and quoted strings
+func tokenizePCL(expr string) ([]pclToken, error) {
+	var tokens []pclToken
+	for i := 0; i < len(expr); {
+		ch := expr[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '(' || ch == ')':
+			tokens = append(tokens, pclToken{text: string(ch)})
+			i++
+		case ch == '\'' || ch == '"':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(expr) && expr[j] != ch; j++ {
+				if expr[j] == '\\' && j+1 < len(expr) {
+					j++
+				}
+				sb.WriteByte(expr[j])
+			}
+			if j >= len(expr) {
+				return nil, fmt.Errorf("unterminated string starting at position %d", i)
+			}
+			tokens = append(tokens, pclToken{text: sb.String(), quoted: true})
+			i = j + 1
+		default:
+			j := i
+			for j < len(expr) && !strings.ContainsRune(" \t\n\r()'\"", rune(expr[j])) {
+				j++
+			}
+			tokens = append(tokens, pclToken{text: expr[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+// pclParser evaluates a tokenized condition expression against an event
+type pclParser struct {
+	tokens []pclToken
+	pos    int
+	event  map[string]any
+	// paths collects every event path the expression refers to
+	paths []string
+}
+
+// evaluateCondition reports whether a PCL condition expression matches the event
+func evaluateCondition(expr string, event map[string]any) (bool, error) {
+	tokens, err := tokenizePCL(expr)
+	if err != nil {
+		return false, err
+	}
+	p := &pclParser{tokens: tokens, event: event}
+	matched, err := p.parseOr()
+	if err != nil {
+		return false, err
+	}
+	if p.pos < len(p.tokens) {
+		return false, fmt.Errorf("unexpected '%s'", p.tokens[p.pos].text)
+	}
+	return matched, nil
+}
+
+// validateCondition reports the first problem that would stop a PCL condition
+// expression from ever matching: a syntax error, a path outside event. or
+// raw_event., or an invalid regex
+func validateCondition(expr string) error {
+	if strings.TrimSpace(expr) == "" {
+		return fmt.Errorf("expression is empty")
+	}
+	tokens, err := tokenizePCL(expr)
+	if err != nil {
+		return err
+	}
+	p := &pclParser{tokens: tokens, event: map[string]any{}}
+	if _, err := p.parseOr(); err != nil {
+		return err
+	}
+	if p.pos < len(p.tokens) {
+		return fmt.Errorf("unexpected '%s'", p.tokens[p.pos].text)
+	}
+	for _, path := range p.paths {
+		if !strings.HasPrefix(path, "event.") && !strings.HasPrefix(path, "raw_event.") {
+			return fmt.Errorf("path '%s' must start with event. or raw_event. (e.g., 'event.summary')", path)
+		}
+	}
+	return nil
+}
+
+// peekKeyword reports whether the next token is the given unquoted keyword
+func (p *pclParser) peekKeyword(keyword string) bool {
+	return p.pos < len(p.tokens) && !p.tokens[p.pos].quoted && strings.EqualFold(p.tokens[p.pos].text, keyword)
+}
+
+func (p *pclParser) parseOr() (bool, error) {
+	result, err := p.parseAnd()
+	if err != nil {
+		return false, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return false, err
+		}
+		result = result || right
+	}
+	return result, nil
+}
+
+func (p *pclParser) parseAnd() (bool, error) {
+	result, err := p.parseNot()
+	if err != nil {
+		return false, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return false, err
+		}
+		result = result && right
+	}
+	return result, nil
+}
+
+func (p *pclParser) parseNot() (bool, error) {
+	if p.peekKeyword("not") {
+		p.pos++
+		result, err := p.parseNot()
+		return !result, err
+	}
+	if p.peekKeyword("(") {
+		p.pos++
+		result, err := p.parseOr()
+		if err != nil {
+			return false, err
+		}
+		if !p.peekKeyword(")") {
+			return false, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return result, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *pclParser) parseComparison() (bool, error) {
+	if p.pos >= len(p.tokens) {
+		return false, fmt.Errorf("unexpected end of expression")
+	}
+	if p.tokens[p.pos].quoted {
+		return false, fmt.Errorf("expected an event path before '%s'", p.tokens[p.pos].text)
+	}
+	path := p.tokens[p.pos].text
+	p.pos++
+	p.paths = append(p.paths, path)
+
+	value, found := resolveEventPath(p.event, path)
+	switch {
+	case p.peekKeyword("exists"):
+		p.pos++
+		return found, nil
+	case p.peekKeyword("matches"):
+		p.pos++
+	default:
+		return false, fmt.Errorf("expected 'matches' or 'exists' after '%s'", path)
+	}
+
+	mode := "exact"
+	if p.peekKeyword("part") || p.peekKeyword("regex") {
+		mode = strings.ToLower(p.tokens[p.pos].text)
+		p.pos++
+	}
+	if p.pos >= len(p.tokens) || !p.tokens[p.pos].quoted {
+		return false, fmt.Errorf("expected a quoted value after 'matches' for '%s'", path)
+	}
+	pattern := p.tokens[p.pos].text
+	p.pos++
+
+	var re *regexp.Regexp
+	if mode == "regex" {
+		var err error
+		if re, err = regexp.Compile(pattern); err != nil {
+			return false, fmt.Errorf("invalid regex '%s': %w", pattern, err)
+		}
+	}
+
+	if !found {
+		return false, nil
+	}
+	actual := fmt.Sprint(value)
+	switch mode {
+	case "part":
+		return strings.Contains(actual, pattern), nil
+	case "regex":
+		return re.MatchString(actual), nil
+	default:
+		return actual == pattern, nil
+	}
+}
+
+// resolveEventPath looks up a dotted event.* or raw_event.* path in the sample event
+func resolveEventPath(event map[string]any, path string) (any, bool) {
+	parts := strings.Split(path, ".")
+	if len(parts) < 2 || (parts[0] != "event" && parts[0] != "raw_event") {
+		return nil, false
+	}
+	var current any = event
+	for _, part := range parts[1:] {
+		obj, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = obj[part]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// simulateOrchestration walks the rule sets the way PagerDuty does: starting at
+// the first set, the first enabled rule with any matching condition wins, and a
+// route_to naming another set continues evaluation there. When no rule matches
+// the catch-all applies.
+func simulateOrchestration(sets []models.EventOrchestrationRuleSet, catchAll *models.EventOrchestrationCatchAll, event map[string]any) (models.OrchestrationSimulation, error) {
+	result := models.OrchestrationSimulation{}
+	if len(sets) == 0 {
+		result.CatchAll = true
+		if catchAll != nil {
+			result.Actions = &catchAll.Actions
+		}
+		return result, nil
+	}
+
+	byID := make(map[string]models.EventOrchestrationRuleSet, len(sets))
+	for _, set := range sets {
+		byID[set.ID] = set
+	}
+
+	set := sets[0]
+	visited := make(map[string]bool)
+	for {
+		if visited[set.ID] {
+			return result, fmt.Errorf("rule set '%s' is routed to more than once", set.ID)
+		}
+		visited[set.ID] = true
+
+		rule, err := firstMatchingRule(set.Rules, event)
+		if err != nil {
+			return result, fmt.Errorf("set '%s': %w", set.ID, err)
+		}
+		if rule == nil {
+			result.CatchAll = true
+			if catchAll != nil {
+				result.Actions = &catchAll.Actions
+			}
+			return result, nil
+		}
+
+		result.MatchedRules = append(result.MatchedRules, models.OrchestrationSimulationMatch{
+			SetID:   set.ID,
+			RuleID:  rule.ID,
+			Label:   rule.Label,
+			Actions: rule.Actions,
+		})
+		next, ok := byID[rule.Actions.RouteTo]
+		if !ok {
+			result.Actions = &rule.Actions
+			return result, nil
+		}
+		set = next
+	}
+}
+
+// firstMatchingRule returns the first enabled rule that matches the event, or nil.
+// A rule with no conditions always matches.
+func firstMatchingRule(rules []models.EventOrchestrationRule, event map[string]any) (*models.EventOrchestrationRule, error) {
+	for i := range rules {
+		rule := &rules[i]
+		if rule.Disabled {
+			continue
+		}
+		if len(rule.Conditions) == 0 {
+			return rule, nil
+		}
+		for _, condition := range rule.Conditions {
+			matched, err := evaluateCondition(condition.Expression, event)
+			if err != nil {
+				return nil, fmt.Errorf("rule '%s' condition %q: %w", rule.ID, condition.Expression, err)
+			}
+			if matched {
+				return rule, nil
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+)
+
+// TestEvaluateCondition tests the supported PCL operators against a sample event
+func TestEvaluateCondition(t *testing.T) {
+	event := map[string]any{
+		"summary":  "Database connection timeout on db-1",
+		"source":   "db-1",
+		"severity": "critical",
+		"custom_details": map[string]any{
+			"region": "us-east-1",
+		},
+	}
+
+	tests := map[string]bool{
+		`event.source matches 'db-1'`:                                     true,
+		`event.source matches 'db'`:                                       false,
+		`event.summary matches part 'timeout'`:                            true,
+		`event.summary matches regex 'db-[0-9]+$'`:                        true,
+		`event.custom_details.region matches "us-east-1"`:                 true,
+		`event.custom_details.host exists`:                                false,
+		`not event.custom_details.host exists`:                            true,
+		`event.severity matches 'critical' and event.source matches 'x'`:  false,
+		`event.severity matches 'critical' or event.source matches 'x'`:   true,
+		`event.source matches 'x' or (event.severity matches 'critical')`: true,
+	}
+	for expr, want := range tests {
+		got, err := evaluateCondition(expr, event)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", expr, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("Expected %v for %q, got %v", want, expr, got)
+		}
+	}
+
+	for _, expr := range []string{`event.source equals 'db-1'`, `event.source matches db-1`, `event.source matches 'db-1`} {
+		if _, err := evaluateCondition(expr, event); err == nil {
+			t.Errorf("Expected error for %q", expr)
+		}
+	}
+}
+
+// TestSimulateOrchestration tests first-match-wins, disabled rules, set routing, and the catch-all
+func TestSimulateOrchestration(t *testing.T) {
+	sets := []models.EventOrchestrationRuleSet{
+		{ID: "start", Rules: []models.EventOrchestrationRule{
+			{ID: "disabled", Disabled: true, Actions: models.EventOrchestrationRuleActions{RouteTo: "PWRONG"}},
+			{ID: "db", Conditions: []models.EventOrchestrationRuleCondition{{Expression: `event.source matches part 'db'`}}, Actions: models.EventOrchestrationRuleActions{RouteTo: "db-set"}},
+			{ID: "later", Actions: models.EventOrchestrationRuleActions{RouteTo: "PLATER"}},
+		}},
+		{ID: "db-set", Rules: []models.EventOrchestrationRule{
+			{ID: "critical", Conditions: []models.EventOrchestrationRuleCondition{{Expression: `event.severity matches 'critical'`}}, Actions: models.EventOrchestrationRuleActions{RouteTo: "PDBSVC"}},
+		}},
+	}
+	catchAll := &models.EventOrchestrationCatchAll{Actions: models.EventOrchestrationRuleActions{RouteTo: "unrouted"}}
+
+	result, err := simulateOrchestration(sets, catchAll, map[string]any{"source": "db-1", "severity": "critical"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.MatchedRules) != 2 || result.MatchedRules[0].RuleID != "db" || result.MatchedRules[1].RuleID != "critical" {
+		t.Errorf("Expected matches [db critical], got %+v", result.MatchedRules)
+	}
+	if result.CatchAll || result.Actions == nil || result.Actions.RouteTo != "PDBSVC" {
+		t.Errorf("Expected route to PDBSVC, got %+v", result)
+	}
+
+	result, err = simulateOrchestration(sets, catchAll, map[string]any{"source": "db-1", "severity": "info"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.CatchAll || result.Actions.RouteTo != "unrouted" {
+		t.Errorf("Expected catch-all, got %+v", result)
+	}
+}
+
+// TestValidateCondition tests that well-formed expressions pass and common
+// mistakes are reported
+func TestValidateCondition(t *testing.T) {
+	for _, expr := range []string{
+		`event.summary matches part 'timeout'`,
+		`not (event.custom_details.host exists) or raw_event.source matches "db-1"`,
+	} {
+		if err := validateCondition(expr); err != nil {
+			t.Errorf("Expected %q to be valid, got %v", expr, err)
+		}
+	}
+
+	for _, expr := range []string{
+		``,
+		`event.source matches 'db-1`,
+		`(event.source matches 'db-1'`,
+		`event.source == 'db-1'`,
+		`event.source matches 'db-1' and`,
+		`source matches 'db-1'`,
+		`event.summary matches regex 'db-[0-9+'`,
+	} {
+		if err := validateCondition(expr); err == nil {
+			t.Errorf("Expected an error for %q", expr)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	incidentResourceURI = "pagerduty://incidents"
+	serviceResourceURI  = "pagerduty://services"
+)
+
+// RegisterResources registers MCP resources for browsing incidents and services by URI
+func RegisterResources(s *server.MCPServer, c *client.Client) {
+	// pagerduty://incidents
+	s.AddResource(mcp.NewResource(incidentResourceURI, "Open Incidents",
+		mcp.WithResourceDescription("Triggered and acknowledged incidents. Each entry links to a pagerduty://incidents/{incident_id} resource."),
+		mcp.WithMIMEType("application/json"),
+	), listIncidentResourcesHandler(c))
+
+	// pagerduty://incidents/{incident_id}
+	s.AddResourceTemplate(mcp.NewResourceTemplate(incidentResourceURI+"/{incident_id}", "Incident",
+		mcp.WithTemplateDescription("A PagerDuty incident by ID (e.g., pagerduty://incidents/PABC123). Same content as get_incident."),
+		mcp.WithTemplateMIMEType("application/json"),
+	), readIncidentResourceHandler(c))
+
+	// pagerduty://services
+	s.AddResource(mcp.NewResource(serviceResourceURI, "Services",
+		mcp.WithResourceDescription("Services in the account. Each entry links to a pagerduty://services/{service_id} resource."),
+		mcp.WithMIMEType("application/json"),
+	), listServiceResourcesHandler(c))
+
+	// pagerduty://services/{service_id}
+	s.AddResourceTemplate(mcp.NewResourceTemplate(serviceResourceURI+"/{service_id}", "Service",
+		mcp.WithTemplateDescription("A PagerDuty service by ID (e.g., pagerduty://services/PDSVC123). Same content as get_service."),
+		mcp.WithTemplateMIMEType("application/json"),
+	), readServiceResourceHandler(c))
+}
+
+func listIncidentResourcesHandler(c *client.Client) server.ResourceHandlerFunc {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		query := models.IncidentQuery{
+			Statuses: []string{"triggered", "acknowledged"},
+			Limit:    models.MaxPaginationLimit,
+		}
+		data, err := c.GetWithArrayParamsContext(ctx, "/incidents", query.ToArrayParams())
+		if err != nil {
+			return nil, err
+		}
+
+		var resp models.IncidentsResponse
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		resources := make([]mcp.Resource, 0, len(resp.Incidents))
+		for _, incident := range resp.Incidents {
+			resources = append(resources, mcp.NewResource(
+				fmt.Sprintf("%s/%s", incidentResourceURI, incident.ID),
+				fmt.Sprintf("#%d %s", incident.IncidentNumber, incident.Title),
+				mcp.WithResourceDescription(fmt.Sprintf("%s, %s urgency", incident.Status, incident.Urgency)),
+				mcp.WithMIMEType("application/json"),
+			))
+		}
+		return jsonResourceContents(request.Params.URI, resources)
+	}
+}
+
+func readIncidentResourceHandler(c *client.Client) server.ResourceTemplateHandlerFunc {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		incidentID, ok := resourceArgument(request, "incident_id")
+		if !ok {
+			return nil, fmt.Errorf("incident_id is required")
+		}
+
+		var resp models.IncidentResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/incidents/%s", incidentID), nil, &resp); err != nil {
+			return nil, err
+		}
+		return jsonResourceContents(request.Params.URI, resp.Incident)
+	}
+}
+
+func listServiceResourcesHandler(c *client.Client) server.ResourceHandlerFunc {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		params := map[string]string{"limit": fmt.Sprintf("%d", models.MaxPaginationLimit)}
+
+		var resp models.ServicesResponse
+		if err := c.GetJSONWithContext(ctx, "/services", params, &resp); err != nil {
+			return nil, err
+		}
+
+		resources := make([]mcp.Resource, 0, len(resp.Services))
+		for _, service := range resp.Services {
+			resources = append(resources, mcp.NewResource(
+				fmt.Sprintf("%s/%s", serviceResourceURI, service.ID),
+				service.Name,
+				mcp.WithResourceDescription(service.Description),
+				mcp.WithMIMEType("application/json"),
+			))
+		}
+		return jsonResourceContents(request.Params.URI, resources)
+	}
+}
+
+func readServiceResourceHandler(c *client.Client) server.ResourceTemplateHandlerFunc {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		serviceID, ok := resourceArgument(request, "service_id")
+		if !ok {
+			return nil, fmt.Errorf("service_id is required")
+		}
+
+		var resp models.ServiceResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/services/%s", serviceID), nil, &resp); err != nil {
+			return nil, err
+		}
+		return jsonResourceContents(request.Params.URI, resp.Service)
+	}
+}
+
+// resourceArgument extracts a URI template variable from a resource request.
+// Template variables are matched as string slices.
+func resourceArgument(request mcp.ReadResourceRequest, key string) (string, bool) {
+	switch v := request.Params.Arguments[key].(type) {
+	case string:
+		return v, v != ""
+	case []string:
+		if len(v) > 0 && v[0] != "" {
+			return v[0], true
+		}
+	}
+	return "", false
+}
+
+// jsonResourceContents marshals v as the JSON text contents of a resource
+func jsonResourceContents(uri string, v any) ([]mcp.ResourceContents, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource: %w", err)
+	}
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{URI: uri, MIMEType: "application/json", Text: string(data)},
+	}, nil
+}
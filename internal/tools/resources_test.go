@@ -0,0 +1,55 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// TestReadIncidentResource tests that an incident URI is matched and read through the incident API
+func TestReadIncidentResource(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incidents/PABC123" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"incident":{"id":"PABC123","title":"Database down","status":"triggered"}}`))
+	})
+
+	s := server.NewMCPServer("test", "0.0.0", server.WithResourceCapabilities(false, false))
+	RegisterResources(s, c)
+
+	msg := `{"jsonrpc":"2.0","id":1,"method":"resources/read","params":{"uri":"pagerduty://incidents/PABC123"}}`
+	resp := s.HandleMessage(context.Background(), json.RawMessage(msg))
+
+	rpc, ok := resp.(mcp.JSONRPCResponse)
+	if !ok {
+		t.Fatalf("Expected JSON-RPC response, got %T: %v", resp, resp)
+	}
+	result, ok := rpc.Result.(mcp.ReadResourceResult)
+	if !ok || len(result.Contents) != 1 {
+		t.Fatalf("Expected one resource content, got %v", rpc.Result)
+	}
+	contents, ok := result.Contents[0].(mcp.TextResourceContents)
+	if !ok {
+		t.Fatalf("Expected text contents, got %T", result.Contents[0])
+	}
+	if contents.URI != "pagerduty://incidents/PABC123" {
+		t.Errorf("Expected URI 'pagerduty://incidents/PABC123', got '%s'", contents.URI)
+	}
+
+	var incident struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+	}
+	if err := json.Unmarshal([]byte(contents.Text), &incident); err != nil {
+		t.Fatalf("Failed to parse incident: %v", err)
+	}
+	if incident.ID != "PABC123" || incident.Title != "Database down" {
+		t.Errorf("Expected incident PABC123 'Database down', got %+v", incident)
+	}
+}
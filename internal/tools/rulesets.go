@@ -0,0 +1,265 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RegisterRulesetReadTools registers read-only ruleset tools
+func RegisterRulesetReadTools(s *server.MCPServer, c *client.Client) {
+	// list_rulesets
+	s.AddTool(mcp.NewTool("list_rulesets",
+		mcp.WithDescription("List legacy rulesets. Rulesets route and modify incoming events for accounts that have not yet migrated to event orchestrations. Use list_event_orchestrations instead for newer accounts."),
+		mcp.WithTitleAnnotation("List Rulesets"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+	), listRulesetsHandler(c))
+
+	// get_ruleset
+	s.AddTool(mcp.NewTool("get_ruleset",
+		mcp.WithDescription("Get details of a legacy ruleset, including its routing keys and owning team."),
+		mcp.WithTitleAnnotation("Get Ruleset"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("ruleset_id", mcp.Required(), mcp.Description("The unique ruleset ID")),
+	), getRulesetHandler(c))
+
+	// list_ruleset_rules
+	s.AddTool(mcp.NewTool("list_ruleset_rules",
+		mcp.WithDescription("List the event rules in a legacy ruleset in evaluation order. Each rule has conditions on event fields and actions (route, severity, suppress, etc.) applied when it matches."),
+		mcp.WithTitleAnnotation("List Ruleset Rules"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("ruleset_id", mcp.Required(), mcp.Description("The unique ruleset ID")),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+	), listRulesetRulesHandler(c))
+}
+
+// RegisterRulesetWriteTools registers write ruleset tools
+func RegisterRulesetWriteTools(s *server.MCPServer, c *client.Client) {
+	// create_ruleset_rule
+	s.AddTool(mcp.NewTool("create_ruleset_rule",
+		mcp.WithDescription("Create an event rule in a legacy ruleset. Rules are evaluated in position order and the first match applies its actions."),
+		mcp.WithTitleAnnotation("Create Ruleset Rule"),
+		mcp.WithString("ruleset_id", mcp.Required(), mcp.Description("The unique ruleset ID")),
+		mcp.WithString("conditions", mcp.Required(), mcp.Description(`Rule conditions as JSON (e.g., '{"operator":"and","subconditions":[{"operator":"contains","parameters":{"path":"summary","value":"disk"}}]}')`)),
+		mcp.WithString("actions", mcp.Required(), mcp.Description(`Rule actions as JSON (e.g., '{"route":{"value":"PSVC123"},"severity":{"value":"warning"}}'). Supported actions: route, severity, priority, annotate, event_action, suppress, suspend, extractions`)),
+		mcp.WithNumber("position", mcp.Description("Zero-based evaluation position of the rule (default: last)"), mcp.Min(0)),
+		mcp.WithBoolean("disabled", mcp.Description("Create the rule disabled (default: false)")),
+	), createRulesetRuleHandler(c))
+
+	// update_ruleset_rule
+	s.AddTool(mcp.NewTool("update_ruleset_rule",
+		mcp.WithDescription("Update an event rule in a legacy ruleset. Only the provided fields are changed."),
+		mcp.WithTitleAnnotation("Update Ruleset Rule"),
+		mcp.WithString("ruleset_id", mcp.Required(), mcp.Description("The unique ruleset ID")),
+		mcp.WithString("rule_id", mcp.Required(), mcp.Description("The unique rule ID to update")),
+		mcp.WithString("conditions", mcp.Description("New rule conditions as JSON, replacing the existing conditions")),
+		mcp.WithString("actions", mcp.Description("New rule actions as JSON, replacing the existing actions")),
+		mcp.WithNumber("position", mcp.Description("New zero-based evaluation position of the rule"), mcp.Min(0)),
+		mcp.WithBoolean("disabled", mcp.Description("Disable (true) or enable (false) the rule")),
+	), updateRulesetRuleHandler(c))
+
+	// delete_ruleset_rule
+	s.AddTool(mcp.NewTool("delete_ruleset_rule",
+		mcp.WithDescription("WARNING: DESTRUCTIVE - Permanently delete an event rule from a legacy ruleset. Events it matched will fall through to later rules."),
+		mcp.WithTitleAnnotation("Delete Ruleset Rule"),
+		mcp.WithDestructiveHintAnnotation(true),
+		mcp.WithString("ruleset_id", mcp.Required(), mcp.Description("The unique ruleset ID")),
+		mcp.WithString("rule_id", mcp.Required(), mcp.Description("The unique rule ID to delete")),
+	), deleteRulesetRuleHandler(c))
+}
+
+func listRulesetsHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		params := make(map[string]string)
+
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
+		}
+
+		var resp models.RulesetsResponse
+		if err := c.GetJSONWithContext(ctx, "/rulesets", params, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		result := models.ListResponse[models.Ruleset]{Response: resp.Rulesets}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
+	}
+}
+
+func getRulesetHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		rulesetID, ok := getString(args, "ruleset_id")
+		if !ok {
+			return mcp.NewToolResultError("ruleset_id is required"), nil
+		}
+
+		var resp models.RulesetResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/rulesets/%s", rulesetID), nil, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.Ruleset)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func listRulesetRulesHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		rulesetID, ok := getString(args, "ruleset_id")
+		if !ok {
+			return mcp.NewToolResultError("ruleset_id is required"), nil
+		}
+
+		params := make(map[string]string)
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
+		}
+
+		var resp models.RulesetRulesResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/rulesets/%s/rules", rulesetID), params, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		result := models.ListResponse[models.RulesetRule]{Response: resp.Rules}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
+	}
+}
+
+// getRulesetRuleData reads the optional conditions, actions, position, and disabled arguments
+func getRulesetRuleData(args map[string]any) (models.RulesetRuleData, error) {
+	var rule models.RulesetRuleData
+
+	if v, ok := getString(args, "conditions"); ok {
+		var conditions models.RulesetRuleConditions
+		if err := json.Unmarshal([]byte(v), &conditions); err != nil {
+			return rule, fmt.Errorf("invalid conditions JSON: %v", err)
+		}
+		if len(conditions.Subconditions) == 0 {
+			return rule, fmt.Errorf("conditions must contain at least one subcondition")
+		}
+		rule.Conditions = &conditions
+	}
+	if v, ok := getString(args, "actions"); ok {
+		// Reject unknown actions rather than dropping them and sending an empty rule
+		var actions models.RulesetRuleActions
+		decoder := json.NewDecoder(strings.NewReader(v))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&actions); err != nil {
+			return rule, fmt.Errorf("invalid actions JSON: %v", err)
+		}
+		if actions.Route == nil && actions.Severity == nil && actions.Priority == nil && actions.Annotate == nil &&
+			actions.EventAction == nil && actions.Suppress == nil && actions.Suspend == nil && len(actions.Extractions) == 0 {
+			return rule, fmt.Errorf("actions must contain at least one action")
+		}
+		rule.Actions = &actions
+	}
+	if v, ok, err := getInteger(args, "position", 0, math.MaxInt); err != nil {
+		return rule, err
+	} else if ok {
+		rule.Position = &v
+	}
+	if v, ok := getBool(args, "disabled"); ok {
+		rule.Disabled = &v
+	}
+	return rule, nil
+}
+
+func createRulesetRuleHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		rulesetID, ok := getString(args, "ruleset_id")
+		if !ok {
+			return mcp.NewToolResultError("ruleset_id is required"), nil
+		}
+
+		rule, err := getRulesetRuleData(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if rule.Conditions == nil {
+			return mcp.NewToolResultError("conditions is required"), nil
+		}
+		if rule.Actions == nil {
+			return mcp.NewToolResultError("actions is required"), nil
+		}
+
+		req := models.RulesetRuleRequest{Rule: rule}
+
+		var resp models.RulesetRuleResponse
+		if err := c.PostJSONWithContext(ctx, fmt.Sprintf("/rulesets/%s/rules", rulesetID), req, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.Rule)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func updateRulesetRuleHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		rulesetID, ok := getString(args, "ruleset_id")
+		if !ok {
+			return mcp.NewToolResultError("ruleset_id is required"), nil
+		}
+
+		ruleID, ok := getString(args, "rule_id")
+		if !ok {
+			return mcp.NewToolResultError("rule_id is required"), nil
+		}
+
+		rule, err := getRulesetRuleData(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if rule == (models.RulesetRuleData{}) {
+			return mcp.NewToolResultError("at least one of conditions, actions, position, or disabled is required"), nil
+		}
+
+		req := models.RulesetRuleRequest{Rule: rule}
+
+		var resp models.RulesetRuleResponse
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/rulesets/%s/rules/%s", rulesetID, ruleID), req, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		data, _ := json.Marshal(resp.Rule)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func deleteRulesetRuleHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		rulesetID, ok := getString(args, "ruleset_id")
+		if !ok {
+			return mcp.NewToolResultError("ruleset_id is required"), nil
+		}
+
+		ruleID, ok := getString(args, "rule_id")
+		if !ok {
+			return mcp.NewToolResultError("rule_id is required"), nil
+		}
+
+		if _, err := c.DeleteWithContext(ctx, fmt.Sprintf("/rulesets/%s/rules/%s", rulesetID, ruleID)); err != nil {
+			return toolError(err), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Rule %s deleted from ruleset %s successfully", ruleID, rulesetID)), nil
+	}
+}
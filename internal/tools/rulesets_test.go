@@ -0,0 +1,118 @@
+package tools
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestUpdateRulesetRule_PartialUpdate tests that only the provided fields are sent
+func TestUpdateRulesetRule_PartialUpdate(t *testing.T) {
+	var body string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/rulesets/RS1/rules/R1" {
+			t.Errorf("Expected PUT /rulesets/RS1/rules/R1, got %s %s", r.Method, r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Write([]byte(`{"rule":{"id":"R1","position":0,"disabled":true}}`))
+	})
+
+	result, err := updateRulesetRuleHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"ruleset_id": "RS1",
+		"rule_id":    "R1",
+		"disabled":   true,
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if body != `{"rule":{"disabled":true}}` {
+		t.Errorf("Expected only disabled to be sent, got %s", body)
+	}
+}
+
+// TestRulesetRule_Validation tests that missing or malformed rule fields are rejected before calling the API
+func TestRulesetRule_Validation(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no API call, got %s %s", r.Method, r.URL.Path)
+	})
+
+	tests := []struct {
+		name string
+		call func() bool
+	}{
+		{"create without actions", func() bool {
+			result, _ := createRulesetRuleHandler(c)(context.Background(), newToolRequest(map[string]any{
+				"ruleset_id": "RS1",
+				"conditions": `{"operator":"and","subconditions":[{"operator":"exists","parameters":{"path":"summary"}}]}`,
+			}))
+			return result.IsError
+		}},
+		{"create with empty conditions", func() bool {
+			result, _ := createRulesetRuleHandler(c)(context.Background(), newToolRequest(map[string]any{
+				"ruleset_id": "RS1",
+				"conditions": `{"operator":"and","subconditions":[]}`,
+				"actions":    `{"severity":{"value":"info"}}`,
+			}))
+			return result.IsError
+		}},
+		{"create with an unknown action", func() bool {
+			result, _ := createRulesetRuleHandler(c)(context.Background(), newToolRequest(map[string]any{
+				"ruleset_id": "RS1",
+				"conditions": `{"operator":"and","subconditions":[{"operator":"exists","parameters":{"path":"summary"}}]}`,
+				"actions":    `{"sevrity":{"value":"info"}}`,
+			}))
+			return result.IsError
+		}},
+		{"update with empty actions", func() bool {
+			result, _ := updateRulesetRuleHandler(c)(context.Background(), newToolRequest(map[string]any{
+				"ruleset_id": "RS1",
+				"rule_id":    "R1",
+				"actions":    `{}`,
+			}))
+			return result.IsError
+		}},
+		{"update without fields", func() bool {
+			result, _ := updateRulesetRuleHandler(c)(context.Background(), newToolRequest(map[string]any{
+				"ruleset_id": "RS1",
+				"rule_id":    "R1",
+			}))
+			return result.IsError
+		}},
+	}
+	for _, tt := range tests {
+		if !tt.call() {
+			t.Errorf("%s: expected an error result", tt.name)
+		}
+	}
+}
+
+// TestCreateRulesetRule_Suspend tests that the suspend action is sent to the API
+func TestCreateRulesetRule_Suspend(t *testing.T) {
+	var body string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Write([]byte(`{"rule":{"id":"R1"}}`))
+	})
+
+	result, err := createRulesetRuleHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"ruleset_id": "RS1",
+		"conditions": `{"operator":"and","subconditions":[{"operator":"exists","parameters":{"path":"summary"}}]}`,
+		"actions":    `{"suspend":{"value":300}}`,
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if !strings.Contains(body, `"actions":{"suspend":{"value":300}}`) {
+		t.Errorf("Expected the suspend action to be sent, got %s", body)
+	}
+}
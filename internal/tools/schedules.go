@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"time"
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
@@ -20,6 +24,7 @@ func RegisterScheduleReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithString("query", mcp.Description("Filter schedules by name (partial match supported)")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+		mcp.WithNumber("offset", mcp.Description("Number of records to skip. Pass next_offset from the previous response to fetch the next page."), mcp.Min(0)),
 	), listSchedulesHandler(c))
 
 	// get_schedule
@@ -30,6 +35,8 @@ func RegisterScheduleReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("schedule_id", mcp.Required(), mcp.Description("The unique schedule ID (e.g., 'PSCHED123')")),
 		mcp.WithString("since", mcp.Description("Start of date range in ISO 8601 format (e.g., '2024-01-15T00:00:00Z'). Used to render on-call periods.")),
 		mcp.WithString("until", mcp.Description("End of date range in ISO 8601 format (e.g., '2024-01-22T00:00:00Z'). Used to render on-call periods.")),
+		mcp.WithBoolean("overflow", mcp.Description("Return on-call periods that straddle since or until whole, with their true start and end, instead of clipped to the range (default: false)")),
+		mcp.WithString("time_zone", mcp.Description(timeZoneDescription)),
 	), getScheduleHandler(c))
 
 	// list_schedule_users
@@ -40,7 +47,41 @@ func RegisterScheduleReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("schedule_id", mcp.Required(), mcp.Description("The unique schedule ID (e.g., 'PSCHED123')")),
 		mcp.WithString("since", mcp.Description("Start of date range in ISO 8601 format (e.g., '2024-01-15T00:00:00Z')")),
 		mcp.WithString("until", mcp.Description("End of date range in ISO 8601 format (e.g., '2024-01-22T00:00:00Z')")),
+		mcp.WithBoolean("overflow", mcp.Description("Return on-call periods that straddle since or until whole, with their true start and end, instead of clipped to the range (default: false)")),
 	), listScheduleUsersHandler(c))
+
+	// get_schedule_coverage_gaps
+	s.AddTool(mcp.NewTool("get_schedule_coverage_gaps",
+		mcp.WithDescription("Find time ranges within a window where a schedule has no one on call. Returns a list of gaps with start and end times, plus the rendered coverage percentage."),
+		mcp.WithTitleAnnotation("Get Schedule Coverage Gaps"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("schedule_id", mcp.Required(), mcp.Description("The unique schedule ID (e.g., 'PSCHED123')")),
+		mcp.WithString("since", mcp.Required(), mcp.Description("Start of date range in ISO 8601 format (e.g., '2024-01-15T00:00:00Z')")),
+		mcp.WithString("until", mcp.Required(), mcp.Description("End of date range in ISO 8601 format (e.g., '2024-01-22T00:00:00Z')")),
+	), getScheduleCoverageGapsHandler(c))
+
+	// get_oncall_handoff
+	s.AddTool(mcp.NewTool("get_oncall_handoff",
+		mcp.WithDescription("Find when on-call next passes to someone else on a schedule, and who hands off to whom. Uses the final schedule, so overrides are included. Also lists every handoff in the look-ahead window. Answers 'who takes over and when?'"),
+		mcp.WithTitleAnnotation("Get On-Call Handoff"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("schedule_id", mcp.Required(), mcp.Description("The unique schedule ID (e.g., 'PSCHED123')")),
+		mcp.WithString("since", mcp.Description("Start of the look-ahead window in ISO 8601 format (default: now)")),
+		mcp.WithNumber("hours", mcp.Description("Length of the look-ahead window in hours (default: 168, one week)"), mcp.Min(1), mcp.Max(2160)),
+		mcp.WithString("time_zone", mcp.Description(timeZoneDescription)),
+	), getOncallHandoffHandler(c))
+
+	// preview_schedule
+	s.AddTool(mcp.NewTool("preview_schedule",
+		mcp.WithDescription("Render the on-call timeline of a proposed schedule without creating it. Returns who would be on call and when, the coverage percentage, and any gaps. Use to validate a rotation before creating it."),
+		mcp.WithTitleAnnotation("Preview Schedule"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("time_zone", mcp.Required(), mcp.Description("IANA time zone identifier (e.g., 'America/New_York', 'Europe/London', 'UTC')")),
+		mcp.WithString("schedule_layers", mcp.Required(), mcp.Description(`JSON array of rotation layers. Each layer needs start, rotation_virtual_start, rotation_turn_length_seconds, and users (e.g., '[{"start":"2024-01-15T09:00:00Z","rotation_virtual_start":"2024-01-15T09:00:00Z","rotation_turn_length_seconds":604800,"users":[{"user":{"id":"PUSER1","type":"user_reference"}}]}]'). Optional: name, end, restrictions.`)),
+		mcp.WithString("since", mcp.Required(), mcp.Description("Start of the preview range in ISO 8601 format (e.g., '2024-01-15T00:00:00Z')")),
+		mcp.WithString("until", mcp.Required(), mcp.Description("End of the preview range in ISO 8601 format (e.g., '2024-01-22T00:00:00Z')")),
+		mcp.WithString("name", mcp.Description("Name for the proposed schedule (default: 'Preview')")),
+	), previewScheduleHandler(c))
 }
 
 // RegisterScheduleWriteTools registers write schedule tools
@@ -83,18 +124,24 @@ func listSchedulesHandler(c *client.Client) server.ToolHandlerFunc {
 		if v, ok := getString(args, "query"); ok {
 			params["query"] = v
 		}
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
+		}
+		if v, ok, err := getInteger(args, "offset", 0, math.MaxInt); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["offset"] = fmt.Sprintf("%d", v)
 		}
 
 		var resp models.SchedulesResponse
-		if err := c.GetJSON("/schedules", params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, "/schedules", params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		result := models.ListResponse[models.Schedule]{Response: resp.Schedules}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		result := models.ListResponse[models.Schedule]{Response: resp.Schedules}.WithPage(resp.Offset, resp.More, resp.Total)
+		return listResult(result), nil
 	}
 }
 
@@ -107,17 +154,35 @@ func getScheduleHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		params := make(map[string]string)
-		if v, ok := getString(args, "since"); ok {
+		if v, ok, err := getDateTime(args, "since"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
 			params["since"] = v
 		}
-		if v, ok := getString(args, "until"); ok {
+		if v, ok, err := getDateTime(args, "until"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
 			params["until"] = v
 		}
 
-		var resp models.ScheduleResponse
-		if err := c.GetJSON(fmt.Sprintf("/schedules/%s", scheduleID), params, &resp); err != nil {
+		if v, ok := getBool(args, "overflow"); ok && v {
+			params["overflow"] = "true"
+		}
+		loc, hasZone, err := getTimeZone(args)
+		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
+		if hasZone {
+			params["time_zone"] = loc.String()
+		}
+
+		var resp models.ScheduleResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/schedules/%s", scheduleID), params, &resp); err != nil {
+			return toolError(err), nil
+		}
+		if hasZone {
+			localizeSchedule(&resp.Schedule, loc)
+		}
 
 		data, _ := json.Marshal(resp.Schedule)
 		return mcp.NewToolResultText(string(data)), nil
@@ -133,24 +198,288 @@ func listScheduleUsersHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		params := make(map[string]string)
-		if v, ok := getString(args, "since"); ok {
+		if v, ok, err := getDateTime(args, "since"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
 			params["since"] = v
 		}
-		if v, ok := getString(args, "until"); ok {
+		if v, ok, err := getDateTime(args, "until"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
 			params["until"] = v
 		}
 
+		if v, ok := getBool(args, "overflow"); ok && v {
+			params["overflow"] = "true"
+		}
 		var resp models.ScheduleUsersResponse
-		if err := c.GetJSON(fmt.Sprintf("/schedules/%s/users", scheduleID), params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/schedules/%s/users", scheduleID), params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		result := models.ListResponse[models.User]{Response: resp.Users}
-		data, _ := json.Marshal(result)
+		return listResult(result), nil
+	}
+}
+
+func getScheduleCoverageGapsHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		scheduleID, ok := getString(args, "schedule_id")
+		if !ok {
+			return mcp.NewToolResultError("schedule_id is required"), nil
+		}
+
+		sinceStr, ok, err := getDateTime(args, "since")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if !ok {
+			return mcp.NewToolResultError("since is required"), nil
+		}
+
+		untilStr, ok, err := getDateTime(args, "until")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if !ok {
+			return mcp.NewToolResultError("until is required"), nil
+		}
+
+		since, _ := time.Parse(time.RFC3339, sinceStr)
+		until, _ := time.Parse(time.RFC3339, untilStr)
+
+		if !until.After(since) {
+			return mcp.NewToolResultError("until must be after since"), nil
+		}
+
+		params := map[string]string{
+			"since": sinceStr,
+			"until": untilStr,
+		}
+
+		var resp models.ScheduleResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/schedules/%s", scheduleID), params, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		report := models.ScheduleCoverageReport{
+			ScheduleID: scheduleID,
+			Since:      sinceStr,
+			Until:      untilStr,
+			Gaps:       []models.ScheduleCoverageGap{},
+		}
+
+		var entries []models.RenderedScheduleEntry
+		if resp.Schedule.FinalSchedule != nil {
+			entries = resp.Schedule.FinalSchedule.RenderedScheduleEntries
+			report.CoveragePercentage = resp.Schedule.FinalSchedule.RenderedCoveragePercentage
+		}
+
+		gaps, err := findCoverageGaps(entries, since, until)
+		if err != nil {
+			return toolError(err), nil
+		}
+		if gaps != nil {
+			report.Gaps = gaps
+		}
+
+		// Cross-check the computed gaps against PagerDuty's own coverage figure
+		switch {
+		case len(report.Gaps) > 0 && report.CoveragePercentage >= 100:
+			report.Warning = "Gaps were found but PagerDuty reports 100% coverage; verify the time range"
+		case len(report.Gaps) == 0 && report.CoveragePercentage > 0 && report.CoveragePercentage < 100:
+			report.Warning = fmt.Sprintf("No gaps were found but PagerDuty reports %.1f%% coverage", report.CoveragePercentage)
+		}
+
+		data, _ := json.Marshal(report)
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
 
+func getOncallHandoffHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		scheduleID, ok := getString(args, "schedule_id")
+		if !ok {
+			return mcp.NewToolResultError("schedule_id is required"), nil
+		}
+
+		since := time.Now().UTC().Truncate(time.Second)
+		if v, ok, err := getDateTime(args, "since"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			since, _ = time.Parse(time.RFC3339, v)
+		}
+		hours := 168
+		if v, ok, err := getInteger(args, "hours", 1, 2160); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			hours = v
+		}
+		until := since.Add(time.Duration(hours) * time.Hour)
+
+		loc, hasZone, err := getTimeZone(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// overflow keeps the shifts straddling the window whole, so the window
+		// edges aren't mistaken for handoffs
+		params := map[string]string{
+			"since":    since.Format(time.RFC3339),
+			"until":    until.Format(time.RFC3339),
+			"overflow": "true",
+		}
+		var resp models.ScheduleResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/schedules/%s", scheduleID), params, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		var entries []models.RenderedScheduleEntry
+		if resp.Schedule.FinalSchedule != nil {
+			entries = resp.Schedule.FinalSchedule.RenderedScheduleEntries
+		}
+		current, handoffs := findHandoffs(entries, since, until)
+
+		report := models.OncallHandoffReport{
+			ScheduleID:    scheduleID,
+			ScheduleName:  resp.Schedule.Name,
+			Since:         params["since"],
+			Until:         params["until"],
+			CurrentOncall: current,
+			Handoffs:      handoffs,
+		}
+		if hasZone {
+			for i := range report.Handoffs {
+				report.Handoffs[i].At = report.Handoffs[i].At.InLocation(loc)
+			}
+		}
+		if len(report.Handoffs) > 0 {
+			report.NextHandoff = &report.Handoffs[0]
+		}
+		report.Summary = handoffSummary(report, hours)
+
+		data, _ := json.Marshal(report)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+// findHandoffs returns who is on call at since and every change of on-call
+// user in (since, until]. Consecutive entries for the same user are one shift,
+// and a gap between entries is a handoff to and then from nobody.
+func findHandoffs(entries []models.RenderedScheduleEntry, since, until time.Time) (*models.UserReference, []models.OncallHandoff) {
+	sorted := make([]models.RenderedScheduleEntry, len(entries))
+	copy(sorted, entries)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start.Time)
+	})
+
+	handoffs := []models.OncallHandoff{}
+	var current, onCall *models.UserReference
+	cursor := since
+	add := func(at time.Time, incoming *models.UserReference) {
+		if at.After(since) && !at.After(until) {
+			handoffs = append(handoffs, models.OncallHandoff{At: models.PDTime{Time: at}, Outgoing: onCall, Incoming: incoming})
+		}
+		onCall = incoming
+	}
+
+	for i := range sorted {
+		entry := sorted[i]
+		if !entry.End.After(cursor) {
+			continue
+		}
+		if entry.Start.After(until) {
+			break
+		}
+		if !entry.Start.After(since) {
+			// The shift in progress at the start of the window
+			current = &sorted[i].User
+			onCall = current
+			cursor = entry.End.Time
+			continue
+		}
+		if entry.Start.After(cursor) && onCall != nil {
+			add(cursor, nil)
+		}
+		if onCall == nil || onCall.ID != entry.User.ID {
+			add(entry.Start.Time, &sorted[i].User)
+		}
+		cursor = entry.End.Time
+	}
+	if onCall != nil && cursor.Before(until) {
+		add(cursor, nil)
+	}
+	return current, handoffs
+}
+
+// handoffSummary describes the next handoff in one sentence
+func handoffSummary(report models.OncallHandoffReport, hours int) string {
+	label := func(user *models.UserReference) string {
+		if user == nil {
+			return "nobody"
+		}
+		return referenceLabel(user.Summary, user.ID)
+	}
+	if report.NextHandoff == nil {
+		if report.CurrentOncall == nil {
+			return fmt.Sprintf("Nobody is on call for the next %d hours", hours)
+		}
+		return fmt.Sprintf("%s stays on call for the next %d hours", label(report.CurrentOncall), hours)
+	}
+	next := report.NextHandoff
+	return fmt.Sprintf("%s hands off to %s at %s (%d handoff(s) in the next %d hours)",
+		label(next.Outgoing), label(next.Incoming), next.At, len(report.Handoffs), hours)
+}
+
+// findCoverageGaps returns the ranges within [since, until) not covered by any entry
+func findCoverageGaps(entries []models.RenderedScheduleEntry, since, until time.Time) ([]models.ScheduleCoverageGap, error) {
+	type span struct{ start, end time.Time }
+
+	spans := make([]span, 0, len(entries))
+	for _, e := range entries {
+		if e.Start.IsZero() || e.End.IsZero() {
+			return nil, fmt.Errorf("schedule entry for %s is missing a start or end time", e.User.ID)
+		}
+		spans = append(spans, span{e.Start.Time, e.End.Time})
+	}
+
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].start.Before(spans[j].start)
+	})
+
+	var gaps []models.ScheduleCoverageGap
+	addGap := func(start, end time.Time) {
+		gaps = append(gaps, models.ScheduleCoverageGap{
+			GapStart: start.UTC().Format(time.RFC3339),
+			GapEnd:   end.UTC().Format(time.RFC3339),
+		})
+	}
+
+	cursor := since
+	for _, sp := range spans {
+		if !sp.end.After(cursor) {
+			continue
+		}
+		if sp.start.After(cursor) {
+			if !sp.start.Before(until) {
+				break
+			}
+			addGap(cursor, sp.start)
+		}
+		cursor = sp.end
+		if !cursor.Before(until) {
+			break
+		}
+	}
+	if cursor.Before(until) {
+		addGap(cursor, until)
+	}
+
+	return gaps, nil
+}
+
 func createScheduleHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
@@ -178,8 +507,8 @@ func createScheduleHandler(c *client.Client) server.ToolHandlerFunc {
 		req := models.ScheduleCreateRequest{Schedule: schedule}
 
 		var resp models.ScheduleResponse
-		if err := c.PostJSON("/schedules", req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PostJSONWithContext(ctx, "/schedules", req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Schedule)
@@ -200,12 +529,18 @@ func createScheduleOverrideHandler(c *client.Client) server.ToolHandlerFunc {
 			return mcp.NewToolResultError("user_id is required"), nil
 		}
 
-		start, ok := getString(args, "start")
+		start, ok, err := getDateTime(args, "start")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if !ok {
 			return mcp.NewToolResultError("start is required"), nil
 		}
 
-		end, ok := getString(args, "end")
+		end, ok, err := getDateTime(args, "end")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if !ok {
 			return mcp.NewToolResultError("end is required"), nil
 		}
@@ -222,8 +557,8 @@ func createScheduleOverrideHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.ScheduleOverrideResponse
-		if err := c.PostJSON(fmt.Sprintf("/schedules/%s/overrides", scheduleID), override, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PostJSONWithContext(ctx, fmt.Sprintf("/schedules/%s/overrides", scheduleID), override, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Override)
@@ -256,11 +591,123 @@ func updateScheduleHandler(c *client.Client) server.ToolHandlerFunc {
 		req := models.ScheduleUpdateRequest{Schedule: schedule}
 
 		var resp models.ScheduleResponse
-		if err := c.PutJSON(fmt.Sprintf("/schedules/%s", scheduleID), req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/schedules/%s", scheduleID), req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Schedule)
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
+
+// parseScheduleLayers decodes and validates the schedule_layers JSON argument
+func parseScheduleLayers(value string) ([]models.ScheduleLayerCreate, error) {
+	var layers []models.ScheduleLayerCreate
+	if err := json.Unmarshal([]byte(value), &layers); err != nil {
+		return nil, fmt.Errorf("invalid schedule_layers JSON: %v", err)
+	}
+	if len(layers) == 0 {
+		return nil, fmt.Errorf("schedule_layers must contain at least one layer")
+	}
+	for i, layer := range layers {
+		switch {
+		case layer.Start == "":
+			return nil, fmt.Errorf("schedule_layers[%d]: start is required", i)
+		case layer.RotationVirtualStart == "":
+			return nil, fmt.Errorf("schedule_layers[%d]: rotation_virtual_start is required", i)
+		case layer.RotationTurnLengthSeconds <= 0:
+			return nil, fmt.Errorf("schedule_layers[%d]: rotation_turn_length_seconds must be positive", i)
+		case len(layer.Users) == 0:
+			return nil, fmt.Errorf("schedule_layers[%d]: users must contain at least one user", i)
+		}
+	}
+	return layers, nil
+}
+
+func previewScheduleHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		loc, ok, err := getTimeZone(args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if !ok {
+			return mcp.NewToolResultError("time_zone is required"), nil
+		}
+
+		layersJSON, ok := getString(args, "schedule_layers")
+		if !ok {
+			return mcp.NewToolResultError("schedule_layers is required"), nil
+		}
+		layers, err := parseScheduleLayers(layersJSON)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		sinceStr, ok, err := getDateTime(args, "since")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if !ok {
+			return mcp.NewToolResultError("since is required"), nil
+		}
+
+		untilStr, ok, err := getDateTime(args, "until")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if !ok {
+			return mcp.NewToolResultError("until is required"), nil
+		}
+
+		since, _ := time.Parse(time.RFC3339, sinceStr)
+		until, _ := time.Parse(time.RFC3339, untilStr)
+
+		if !until.After(since) {
+			return mcp.NewToolResultError("until must be after since"), nil
+		}
+
+		name := "Preview"
+		if v, ok := getString(args, "name"); ok {
+			name = v
+		}
+
+		req := models.ScheduleCreateRequest{Schedule: models.ScheduleCreateData{
+			Type:           "schedule",
+			Name:           name,
+			TimeZone:       loc.String(),
+			ScheduleLayers: layers,
+		}}
+
+		// The preview endpoint renders the schedule for since/until without persisting it
+		query := url.Values{"since": {sinceStr}, "until": {untilStr}}
+		var resp models.ScheduleResponse
+		if err := c.PostJSONWithContext(ctx, "/schedules/preview?"+query.Encode(), req, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		preview := models.SchedulePreview{
+			Since:   sinceStr,
+			Until:   untilStr,
+			Entries: []models.RenderedScheduleEntry{},
+			Gaps:    []models.ScheduleCoverageGap{},
+		}
+		if resp.Schedule.FinalSchedule != nil {
+			if entries := resp.Schedule.FinalSchedule.RenderedScheduleEntries; entries != nil {
+				preview.Entries = entries
+			}
+			preview.CoveragePercentage = resp.Schedule.FinalSchedule.RenderedCoveragePercentage
+		}
+
+		gaps, err := findCoverageGaps(preview.Entries, since, until)
+		if err != nil {
+			return toolError(err), nil
+		}
+		if gaps != nil {
+			preview.Gaps = gaps
+		}
+
+		data, _ := json.Marshal(preview)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
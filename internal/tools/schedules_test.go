@@ -0,0 +1,296 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestFindCoverageGaps tests gap detection over contiguous, overlapping, and gapped schedule entries
+func TestFindCoverageGaps(t *testing.T) {
+	since := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)
+
+	entry := func(start, end string) models.RenderedScheduleEntry {
+		return models.RenderedScheduleEntry{Start: mustPDTime(t, start), End: mustPDTime(t, end)}
+	}
+
+	tests := []struct {
+		name    string
+		entries []models.RenderedScheduleEntry
+		want    []models.ScheduleCoverageGap
+	}{
+		{
+			name: "contiguous",
+			entries: []models.RenderedScheduleEntry{
+				entry("2024-01-15T00:00:00Z", "2024-01-15T12:00:00Z"),
+				entry("2024-01-15T12:00:00Z", "2024-01-16T00:00:00Z"),
+			},
+			want: nil,
+		},
+		{
+			name: "overlapping and unsorted",
+			entries: []models.RenderedScheduleEntry{
+				entry("2024-01-15T10:00:00Z", "2024-01-16T00:00:00Z"),
+				entry("2024-01-15T00:00:00Z", "2024-01-15T14:00:00Z"),
+			},
+			want: nil,
+		},
+		{
+			name: "gapped",
+			entries: []models.RenderedScheduleEntry{
+				entry("2024-01-15T02:00:00Z", "2024-01-15T08:00:00Z"),
+				entry("2024-01-15T10:00:00-05:00", "2024-01-15T18:00:00Z"),
+			},
+			want: []models.ScheduleCoverageGap{
+				{GapStart: "2024-01-15T00:00:00Z", GapEnd: "2024-01-15T02:00:00Z"},
+				{GapStart: "2024-01-15T08:00:00Z", GapEnd: "2024-01-15T15:00:00Z"},
+				{GapStart: "2024-01-15T18:00:00Z", GapEnd: "2024-01-16T00:00:00Z"},
+			},
+		},
+		{
+			name:    "no entries",
+			entries: nil,
+			want: []models.ScheduleCoverageGap{
+				{GapStart: "2024-01-15T00:00:00Z", GapEnd: "2024-01-16T00:00:00Z"},
+			},
+		},
+		{
+			name: "entries extend beyond range",
+			entries: []models.RenderedScheduleEntry{
+				entry("2024-01-14T20:00:00Z", "2024-01-15T06:00:00Z"),
+				entry("2024-01-15T20:00:00Z", "2024-01-16T08:00:00Z"),
+			},
+			want: []models.ScheduleCoverageGap{
+				{GapStart: "2024-01-15T06:00:00Z", GapEnd: "2024-01-15T20:00:00Z"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findCoverageGaps(tt.entries, since, until)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected %d gap(s), got %d: %v", len(tt.want), len(got), got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Gap %d: expected %v, got %v", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+// TestFindCoverageGaps_MissingTime tests that entries without a start or end are reported
+func TestFindCoverageGaps_MissingTime(t *testing.T) {
+	since := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	until := since.Add(24 * time.Hour)
+
+	entries := []models.RenderedScheduleEntry{{End: mustPDTime(t, "2024-01-15T12:00:00Z")}}
+	if _, err := findCoverageGaps(entries, since, until); err == nil {
+		t.Error("Expected error for missing entry start, got nil")
+	}
+}
+
+// mustPDTime parses a timestamp or fails the test
+func mustPDTime(t *testing.T, s string) models.PDTime {
+	t.Helper()
+	pt, err := models.ParsePDTime(s)
+	if err != nil {
+		t.Fatalf("Failed to parse %q: %v", s, err)
+	}
+	return pt
+}
+
+// previewLayersJSON is a single weekly rotation layer used by the preview tests
+const previewLayersJSON = `[{"start":"2024-01-15T00:00:00Z","rotation_virtual_start":"2024-01-15T00:00:00Z","rotation_turn_length_seconds":604800,"users":[{"user":{"id":"PUSER1","type":"user_reference"}}]}]`
+
+// TestPreviewSchedule tests that the proposed layers are posted to the preview endpoint and gaps are reported
+func TestPreviewSchedule(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/schedules/preview" {
+			t.Errorf("Expected POST /schedules/preview, got %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("since") != "2024-01-15T00:00:00Z" || r.URL.Query().Get("until") != "2024-01-16T00:00:00Z" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		var req models.ScheduleCreateRequest
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Errorf("Failed to parse request body: %v", err)
+		}
+		if len(req.Schedule.ScheduleLayers) != 1 || req.Schedule.TimeZone != "UTC" {
+			t.Errorf("Unexpected schedule %+v", req.Schedule)
+		}
+		w.Write([]byte(`{"schedule":{"final_schedule":{"rendered_coverage_percentage":50,"rendered_schedule_entries":[
+			{"start":"2024-01-15T00:00:00Z","end":"2024-01-15T12:00:00Z","user":{"id":"PUSER1"}}
+		]}}}`))
+	})
+
+	result, err := previewScheduleHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"time_zone":       "UTC",
+		"schedule_layers": previewLayersJSON,
+		"since":           "2024-01-15T00:00:00Z",
+		"until":           "2024-01-16T00:00:00Z",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var preview models.SchedulePreview
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &preview); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(preview.Entries) != 1 || preview.CoveragePercentage != 50 {
+		t.Errorf("Unexpected preview %+v", preview)
+	}
+	if len(preview.Gaps) != 1 || preview.Gaps[0].GapStart != "2024-01-15T12:00:00Z" {
+		t.Errorf("Expected a gap from 12:00, got %+v", preview.Gaps)
+	}
+}
+
+// TestPreviewSchedule_InvalidLayers tests that malformed layers are rejected before calling the API
+func TestPreviewSchedule_InvalidLayers(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no API call, got %s %s", r.Method, r.URL.Path)
+	})
+
+	tests := []struct {
+		layers string
+		want   string
+	}{
+		{`not json`, "invalid schedule_layers JSON"},
+		{`[]`, "at least one layer"},
+		{`[{"start":"2024-01-15T00:00:00Z","rotation_virtual_start":"2024-01-15T00:00:00Z","rotation_turn_length_seconds":86400,"users":[]}]`, "schedule_layers[0]: users"},
+	}
+	for _, tt := range tests {
+		result, _ := previewScheduleHandler(c)(context.Background(), newToolRequest(map[string]any{
+			"time_zone":       "UTC",
+			"schedule_layers": tt.layers,
+			"since":           "2024-01-15T00:00:00Z",
+			"until":           "2024-01-16T00:00:00Z",
+		}))
+		if !result.IsError {
+			t.Errorf("Expected an error for %s", tt.layers)
+			continue
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, tt.want) {
+			t.Errorf("Expected error containing '%s', got '%s'", tt.want, text)
+		}
+	}
+}
+
+// TestPreviewSchedule_InvalidTimeZone tests that an unknown time zone is rejected before calling the API
+func TestPreviewSchedule_InvalidTimeZone(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no API call, got %s %s", r.Method, r.URL.Path)
+	})
+
+	result, _ := previewScheduleHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"time_zone":       "America/New_Yrok",
+		"schedule_layers": `[{"start":"2024-01-15T00:00:00Z","rotation_virtual_start":"2024-01-15T00:00:00Z","rotation_turn_length_seconds":86400,"users":[{"user":{"id":"PUSER1","type":"user_reference"}}]}]`,
+		"since":           "2024-01-15T00:00:00Z",
+		"until":           "2024-01-16T00:00:00Z",
+	}))
+	if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "IANA time zone") {
+		t.Errorf("Expected a time_zone error, got %v", result.Content)
+	}
+}
+
+// TestGetOncallHandoff tests that handoffs are found between users and around
+// gaps, that consecutive shifts of one user are merged, and that overflow is requested
+func TestGetOncallHandoff(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("overflow") != "true" || q.Get("since") != "2024-01-15T09:00:00Z" || q.Get("until") != "2024-01-17T09:00:00Z" {
+			t.Errorf("Unexpected query %v", q)
+		}
+		w.Write([]byte(`{"schedule":{"id":"PSCHED1","name":"Primary","final_schedule":{"rendered_schedule_entries":[
+			{"start":"2024-01-15T17:00:00Z","end":"2024-01-16T09:00:00Z","user":{"id":"PB","summary":"Bob"}},
+			{"start":"2024-01-14T09:00:00Z","end":"2024-01-15T17:00:00Z","user":{"id":"PA","summary":"Alice"}},
+			{"start":"2024-01-16T09:00:00Z","end":"2024-01-16T12:00:00Z","user":{"id":"PB","summary":"Bob"}},
+			{"start":"2024-01-16T13:00:00Z","end":"2024-01-17T13:00:00Z","user":{"id":"PC","summary":"Carol"}}
+		]}}}`))
+	})
+
+	result, err := getOncallHandoffHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"schedule_id": "PSCHED1",
+		"since":       "2024-01-15T09:00:00Z",
+		"hours":       float64(48),
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var report models.OncallHandoffReport
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &report); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if report.CurrentOncall == nil || report.CurrentOncall.ID != "PA" {
+		t.Errorf("Expected PA on call now, got %+v", report.CurrentOncall)
+	}
+
+	name := func(u *models.UserReference) string {
+		if u == nil {
+			return "-"
+		}
+		return u.ID
+	}
+	var got []string
+	for _, h := range report.Handoffs {
+		got = append(got, h.At.String()+" "+name(h.Outgoing)+">"+name(h.Incoming))
+	}
+	want := []string{
+		"2024-01-15T17:00:00Z PA>PB",
+		"2024-01-16T12:00:00Z PB>-",
+		"2024-01-16T13:00:00Z ->PC",
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected handoffs %v, got %v", want, got)
+	}
+	if report.Summary != "Alice hands off to Bob at 2024-01-15T17:00:00Z (3 handoff(s) in the next 48 hours)" {
+		t.Errorf("Unexpected summary '%s'", report.Summary)
+	}
+}
+
+// TestGetSchedule_Overflow tests that overflow is only sent when requested
+func TestGetSchedule_Overflow(t *testing.T) {
+	var overflow []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		overflow = append(overflow, r.URL.Query().Get("overflow"))
+		w.Write([]byte(`{"schedule":{"id":"PSCHED1","name":"Primary"}}`))
+	})
+
+	for _, args := range []map[string]any{
+		{"schedule_id": "PSCHED1"},
+		{"schedule_id": "PSCHED1", "overflow": true},
+	} {
+		result, err := getScheduleHandler(c)(context.Background(), newToolRequest(args))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Expected success, got error result: %v", result.Content)
+		}
+	}
+	if len(overflow) != 2 || overflow[0] != "" || overflow[1] != "true" {
+		t.Errorf("Expected overflow ['' 'true'], got %q", overflow)
+	}
+}
@@ -0,0 +1,218 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// searchFunc finds resources of one type whose name matches the query
+type searchFunc func(ctx context.Context, c *client.Client, query string, limit int) ([]models.SearchResult, error)
+
+// searchTypes lists the searchable resource types in the order results are interleaved
+var searchTypes = []struct {
+	name   string
+	search searchFunc
+}{
+	{"incidents", searchIncidents},
+	{"services", searchServices},
+	{"teams", searchTeams},
+	{"users", searchUsers},
+	{"schedules", searchSchedules},
+	{"escalation_policies", searchEscalationPolicies},
+}
+
+// RegisterSearchReadTools registers the cross-resource search tool
+func RegisterSearchReadTools(s *server.MCPServer, c *client.Client) {
+	// search
+	s.AddTool(mcp.NewTool("search",
+		mcp.WithDescription("Search for resources by name fragment across incidents, services, teams, users, schedules, and escalation policies. Use this when you know part of a name but not what kind of resource it is. Results are tagged with their type; if one type fails the others are still returned along with the error."),
+		mcp.WithTitleAnnotation("Search Resources"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("query", mcp.Required(), mcp.Description("Name fragment to search for (e.g., 'payments')")),
+		mcp.WithString("types", mcp.Description("Comma-separated resource types to search (default: all). Options: incidents, services, teams, users, schedules, escalation_policies")),
+		mcp.WithNumber("limit", mcp.Description("Maximum total number of results to return (default: 20)"), mcp.Min(1), mcp.Max(100)),
+	), searchHandler(c))
+}
+
+// searchResponse is the search tool output: merged results plus per-type errors
+type searchResponse struct {
+	models.ListResponse[models.SearchResult]
+	Summary string            `json:"summary"`
+	Errors  map[string]string `json:"errors,omitempty"`
+}
+
+func searchHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		query, ok := getString(args, "query")
+		if !ok {
+			return mcp.NewToolResultError("query is required"), nil
+		}
+
+		limit := models.DefaultPaginationLimit
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			limit = v
+		}
+
+		selected := make(map[string]bool)
+		if v, ok := getStringArray(args, "types"); ok {
+			for _, name := range v {
+				known := false
+				for _, t := range searchTypes {
+					if t.name == name {
+						known = true
+						break
+					}
+				}
+				if !known {
+					return mcp.NewToolResultError(fmt.Sprintf("unknown type '%s'", name)), nil
+				}
+				selected[name] = true
+			}
+		}
+
+		// Fan out to each selected type concurrently
+		results := make([][]models.SearchResult, len(searchTypes))
+		errs := forEachConcurrent(ctx, len(searchTypes), maxConcurrentRequests, func(ctx context.Context, i int) error {
+			t := searchTypes[i]
+			if len(selected) > 0 && !selected[t.name] {
+				return nil
+			}
+			var err error
+			results[i], err = t.search(ctx, c, query, limit)
+			return err
+		})
+
+		resp := searchResponse{ListResponse: models.ListResponse[models.SearchResult]{Response: []models.SearchResult{}}}
+		for i, t := range searchTypes {
+			if errs[i] != nil {
+				if resp.Errors == nil {
+					resp.Errors = make(map[string]string)
+				}
+				resp.Errors[t.name] = errs[i].Error()
+			}
+		}
+		resp.Response = interleaveSearchResults(results, limit)
+		resp.Summary = resp.ListResponse.Summary()
+
+		data, _ := json.Marshal(resp)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+// interleaveSearchResults takes one result from each type in turn, so a
+// common fragment that matches many incidents cannot crowd out the other types
+func interleaveSearchResults(results [][]models.SearchResult, limit int) []models.SearchResult {
+	merged := []models.SearchResult{}
+	for round := 0; len(merged) < limit; round++ {
+		added := false
+		for _, typeResults := range results {
+			if round < len(typeResults) && len(merged) < limit {
+				merged = append(merged, typeResults[round])
+				added = true
+			}
+		}
+		if !added {
+			break
+		}
+	}
+	return merged
+}
+
+// searchIncidents matches incident titles locally since the incidents endpoint has no query filter
+func searchIncidents(ctx context.Context, c *client.Client, query string, limit int) ([]models.SearchResult, error) {
+	var resp models.IncidentsResponse
+	params := map[string]string{"limit": fmt.Sprintf("%d", models.MaxPaginationLimit)}
+	if err := c.GetJSONWithContext(ctx, "/incidents", params, &resp); err != nil {
+		return nil, err
+	}
+
+	var results []models.SearchResult
+	needle := strings.ToLower(query)
+	for _, incident := range resp.Incidents {
+		if len(results) == limit {
+			break
+		}
+		if strings.Contains(strings.ToLower(incident.Title), needle) {
+			results = append(results, models.SearchResult{Type: "incident", ID: incident.ID, Name: incident.Title, HTMLURL: incident.HTMLURL})
+		}
+	}
+	return results, nil
+}
+
+func searchServices(ctx context.Context, c *client.Client, query string, limit int) ([]models.SearchResult, error) {
+	var resp models.ServicesResponse
+	if err := c.GetJSONWithContext(ctx, "/services", searchParams(query, limit), &resp); err != nil {
+		return nil, err
+	}
+	results := make([]models.SearchResult, 0, len(resp.Services))
+	for _, service := range resp.Services {
+		results = append(results, models.SearchResult{Type: "service", ID: service.ID, Name: service.Name, HTMLURL: service.HTMLURL})
+	}
+	return results, nil
+}
+
+func searchTeams(ctx context.Context, c *client.Client, query string, limit int) ([]models.SearchResult, error) {
+	var resp models.TeamsResponse
+	if err := c.GetJSONWithContext(ctx, "/teams", searchParams(query, limit), &resp); err != nil {
+		return nil, err
+	}
+	results := make([]models.SearchResult, 0, len(resp.Teams))
+	for _, team := range resp.Teams {
+		results = append(results, models.SearchResult{Type: "team", ID: team.ID, Name: team.Name, HTMLURL: team.HTMLURL})
+	}
+	return results, nil
+}
+
+func searchUsers(ctx context.Context, c *client.Client, query string, limit int) ([]models.SearchResult, error) {
+	var resp models.UsersResponse
+	if err := c.GetJSONWithContext(ctx, "/users", searchParams(query, limit), &resp); err != nil {
+		return nil, err
+	}
+	results := make([]models.SearchResult, 0, len(resp.Users))
+	for _, user := range resp.Users {
+		results = append(results, models.SearchResult{Type: "user", ID: user.ID, Name: user.Name, HTMLURL: user.HTMLURL})
+	}
+	return results, nil
+}
+
+func searchSchedules(ctx context.Context, c *client.Client, query string, limit int) ([]models.SearchResult, error) {
+	var resp models.SchedulesResponse
+	if err := c.GetJSONWithContext(ctx, "/schedules", searchParams(query, limit), &resp); err != nil {
+		return nil, err
+	}
+	results := make([]models.SearchResult, 0, len(resp.Schedules))
+	for _, schedule := range resp.Schedules {
+		results = append(results, models.SearchResult{Type: "schedule", ID: schedule.ID, Name: schedule.Name, HTMLURL: schedule.HTMLURL})
+	}
+	return results, nil
+}
+
+func searchEscalationPolicies(ctx context.Context, c *client.Client, query string, limit int) ([]models.SearchResult, error) {
+	var resp models.EscalationPoliciesResponse
+	if err := c.GetJSONWithContext(ctx, "/escalation_policies", searchParams(query, limit), &resp); err != nil {
+		return nil, err
+	}
+	results := make([]models.SearchResult, 0, len(resp.EscalationPolicies))
+	for _, policy := range resp.EscalationPolicies {
+		results = append(results, models.SearchResult{Type: "escalation_policy", ID: policy.ID, Name: policy.Name, HTMLURL: policy.HTMLURL})
+	}
+	return results, nil
+}
+
+// searchParams builds the query parameters for list endpoints that support name search
+func searchParams(query string, limit int) map[string]string {
+	return map[string]string{
+		"query": query,
+		"limit": fmt.Sprintf("%d", limit),
+	}
+}
@@ -0,0 +1,118 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestSearch_PartialFailure tests that results from healthy types are merged and a failing type is reported
+func TestSearch_PartialFailure(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/services":
+			w.Write([]byte(`{"services":[{"id":"PSVC1","name":"Payments API"}]}`))
+		case "/teams":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error":{"message":"Forbidden"}}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	result, err := searchHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"query": "pay",
+		"types": "services,teams",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var payload struct {
+		Response []struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		} `json:"response"`
+		Errors map[string]string `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &payload); err != nil {
+		t.Fatalf("Failed to parse search output: %v", err)
+	}
+	if len(payload.Response) != 1 || payload.Response[0].Type != "service" || payload.Response[0].ID != "PSVC1" {
+		t.Errorf("Expected the matching service, got %+v", payload.Response)
+	}
+	if _, ok := payload.Errors["teams"]; !ok {
+		t.Errorf("Expected a teams error, got %v", payload.Errors)
+	}
+}
+
+// TestSearch_UnknownType tests that an unknown type is rejected
+func TestSearch_UnknownType(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no API call, got %s %s", r.Method, r.URL.Path)
+	})
+
+	result, _ := searchHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"query": "pay",
+		"types": "widgets",
+	}))
+	if !result.IsError {
+		t.Error("Expected IsError to be true")
+	}
+}
+
+// TestSearch_InterleavesTypes tests that many matching incidents do not crowd out other types
+func TestSearch_InterleavesTypes(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/incidents":
+			w.Write([]byte(`{"incidents":[
+				{"id":"PINC1","title":"Payments slow"},
+				{"id":"PINC2","title":"Payments down"},
+				{"id":"PINC3","title":"Payments errors"},
+				{"id":"PINC4","title":"Payments timeouts"}
+			]}`))
+		case "/services":
+			w.Write([]byte(`{"services":[{"id":"PSVC1","name":"Payments API"}]}`))
+		case "/teams":
+			w.Write([]byte(`{"teams":[{"id":"PTEAM1","name":"Payments"}]}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	result, err := searchHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"query": "pay",
+		"types": "incidents,services,teams",
+		"limit": float64(4),
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var payload struct {
+		Response []struct {
+			ID string `json:"id"`
+		} `json:"response"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &payload); err != nil {
+		t.Fatalf("Failed to parse search output: %v", err)
+	}
+	var ids []string
+	for _, r := range payload.Response {
+		ids = append(ids, r.ID)
+	}
+	if want := []string{"PINC1", "PSVC1", "PTEAM1", "PINC2"}; !slices.Equal(ids, want) {
+		t.Errorf("Expected %v, got %v", want, ids)
+	}
+}
@@ -0,0 +1,108 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxServiceHealthChanges bounds the recent change events in get_service_health
+const maxServiceHealthChanges = 5
+
+func getServiceHealthHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		serviceID, ok := getString(args, "service_id")
+		if !ok {
+			return mcp.NewToolResultError("service_id is required"), nil
+		}
+
+		// The service itself is required; the other parts are best effort
+		var serviceResp models.ServiceResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/services/%s", serviceID), nil, &serviceResp); err != nil {
+			return toolError(err), nil
+		}
+		service := serviceResp.Service
+		health := models.ServiceHealth{
+			Service: models.ServiceReference{
+				ID:      service.ID,
+				Summary: service.Name,
+				HTMLURL: service.HTMLURL,
+			},
+			Status:        service.Status,
+			RecentChanges: []models.ChangeTimelineEntry{},
+		}
+
+		parts := []struct {
+			name  string
+			fetch func(ctx context.Context) error
+		}{
+			{"open incidents", func(ctx context.Context) error {
+				var resp models.IncidentsResponse
+				params := map[string][]string{
+					"service_ids[]": {serviceID},
+					"statuses[]":    {"triggered", "acknowledged"},
+					"total":         {"true"},
+					"limit":         {"1"},
+				}
+				if err := c.GetJSONWithArrayParamsContext(ctx, "/incidents", params, &resp); err != nil {
+					return err
+				}
+				health.OpenIncidentCount = max(resp.Total, len(resp.Incidents))
+				return nil
+			}},
+			{"last incident", func(ctx context.Context) error {
+				var resp models.IncidentsResponse
+				params := map[string][]string{
+					"service_ids[]": {serviceID},
+					"date_range":    {"all"},
+					"sort_by":       {"created_at:desc"},
+					"limit":         {"1"},
+				}
+				if err := c.GetJSONWithArrayParamsContext(ctx, "/incidents", params, &resp); err != nil {
+					return err
+				}
+				if len(resp.Incidents) > 0 {
+					health.LastIncidentAt = resp.Incidents[0].CreatedAt
+				}
+				return nil
+			}},
+			{"recent changes", func(ctx context.Context) error {
+				var resp models.ChangeEventsResponse
+				params := map[string]string{"limit": fmt.Sprintf("%d", maxServiceHealthChanges)}
+				if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/services/%s/change_events", serviceID), params, &resp); err != nil {
+					return err
+				}
+				sort.SliceStable(resp.ChangeEvents, func(i, j int) bool {
+					return resp.ChangeEvents[i].Timestamp.After(resp.ChangeEvents[j].Timestamp.Time)
+				})
+				for _, event := range resp.ChangeEvents[:min(len(resp.ChangeEvents), maxServiceHealthChanges)] {
+					health.RecentChanges = append(health.RecentChanges, models.ChangeTimelineEntry{
+						ID:        event.ID,
+						Timestamp: event.Timestamp,
+						Summary:   event.Summary,
+						Source:    event.Source,
+					})
+				}
+				return nil
+			}},
+		}
+		errs := forEachConcurrent(ctx, len(parts), maxConcurrentRequests, func(ctx context.Context, i int) error {
+			return parts[i].fetch(ctx)
+		})
+		for i, err := range errs {
+			if err != nil {
+				health.Warnings = append(health.Warnings, fmt.Sprintf("%s unavailable: %v", parts[i].name, err))
+			}
+		}
+
+		data, _ := json.Marshal(health)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
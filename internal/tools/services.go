@@ -3,7 +3,11 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"strings"
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
@@ -20,7 +24,10 @@ func RegisterServiceReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithString("query", mcp.Description("Filter services by name (partial match supported)")),
 		mcp.WithString("team_ids", mcp.Description("Filter by owning teams. Comma-separated team IDs (e.g., 'PTEAM1,PTEAM2')")),
+		mcp.WithString("include", mcp.Description("Related objects to return inline instead of as references. Comma-separated: integrations, teams, escalation_policies")),
+		mcp.WithString("fields", mcp.Description("Fields to return for each service, with dot notation for nested fields. Comma-separated (e.g., 'id,name,status,escalation_policy.summary'). Returns all fields when omitted.")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+		mcp.WithNumber("offset", mcp.Description("Number of records to skip. Pass next_offset from the previous response to fetch the next page."), mcp.Min(0)),
 	), listServicesHandler(c))
 
 	// get_service
@@ -30,6 +37,22 @@ func RegisterServiceReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithString("service_id", mcp.Required(), mcp.Description("The unique service ID (e.g., 'PDSVC123')")),
 	), getServiceHandler(c))
+
+	// get_service_health
+	s.AddTool(mcp.NewTool("get_service_health",
+		mcp.WithDescription("Get a one-shot health summary of a service: its status, number of open (triggered or acknowledged) incidents, when its last incident was created, and its 5 most recent change events. Parts that can't be fetched are listed as warnings."),
+		mcp.WithTitleAnnotation("Get Service Health"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("service_id", mcp.Required(), mcp.Description("The unique service ID (e.g., 'PDSVC123')")),
+	), getServiceHealthHandler(c))
+
+	// list_service_teams
+	s.AddTool(mcp.NewTool("list_service_teams",
+		mcp.WithDescription("List the teams a service belongs to. Use before add_service_team or remove_service_team to see current ownership."),
+		mcp.WithTitleAnnotation("List Service Teams"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("service_id", mcp.Required(), mcp.Description("The unique service ID (e.g., 'PDSVC123')")),
+	), listServiceTeamsHandler(c))
 }
 
 // RegisterServiceWriteTools registers write service tools
@@ -52,32 +75,98 @@ func RegisterServiceWriteTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("description", mcp.Description("New service description")),
 		mcp.WithString("escalation_policy_id", mcp.Description("New escalation policy ID to assign (e.g., 'PESCPOL123')")),
 	), updateServiceHandler(c))
+
+	// delete_service
+	s.AddTool(mcp.NewTool("delete_service",
+		mcp.WithDescription("WARNING: DESTRUCTIVE - Permanently delete a service, including its integrations and incident history. PagerDuty refuses to delete a service with open incidents. This action cannot be undone."),
+		mcp.WithTitleAnnotation("Delete Service"),
+		mcp.WithDestructiveHintAnnotation(true),
+		mcp.WithString("service_id", mcp.Required(), mcp.Description("The unique service ID to delete (e.g., 'PDSVC123')")),
+	), deleteServiceHandler(c))
+
+	// add_service_team
+	s.AddTool(mcp.NewTool("add_service_team",
+		mcp.WithDescription("Add a team to the teams that own a service, keeping its existing teams. PagerDuty may derive a service's teams from its escalation policy; if it ignores the change, an error names the policy whose teams to change instead."),
+		mcp.WithTitleAnnotation("Add Service Team"),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithString("service_id", mcp.Required(), mcp.Description("The unique service ID (e.g., 'PDSVC123')")),
+		mcp.WithString("team_id", mcp.Required(), mcp.Description("The team ID to add (e.g., 'PTEAM123')")),
+	), addServiceTeamHandler(c))
+
+	// remove_service_team
+	s.AddTool(mcp.NewTool("remove_service_team",
+		mcp.WithDescription("WARNING: DESTRUCTIVE - Remove a team from the teams that own a service. Members of that team may lose access to the service and its incidents. PagerDuty may derive a service's teams from its escalation policy; if it ignores the change, an error names the policy whose teams to change instead."),
+		mcp.WithTitleAnnotation("Remove Service Team"),
+		mcp.WithDestructiveHintAnnotation(true),
+		mcp.WithString("service_id", mcp.Required(), mcp.Description("The unique service ID (e.g., 'PDSVC123')")),
+		mcp.WithString("team_id", mcp.Required(), mcp.Description("The team ID to remove (e.g., 'PTEAM123')")),
+	), removeServiceTeamHandler(c))
 }
 
 func listServicesHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
-		params := make(map[string]string)
+		var query models.ServiceQuery
 
 		if v, ok := getString(args, "query"); ok {
-			params["query"] = v
+			query.Query = v
 		}
-		if v, ok := getString(args, "team_ids"); ok {
-			params["team_ids[]"] = v
+		if v, ok := getStringArray(args, "team_ids"); ok {
+			query.TeamIDs = v
 		}
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok := getStringArray(args, "include"); ok {
+			for _, include := range v {
+				if include != "integrations" && include != "teams" && include != "escalation_policies" {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid include '%s': expected integrations, teams, or escalation_policies", include)), nil
+				}
+			}
+			query.Includes = v
+		}
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Limit = v
+		}
+		if v, ok, err := getInteger(args, "offset", 0, math.MaxInt); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			query.Offset = v
+		}
+
+		// Included objects are full records rather than references, so decode
+		// them generically to avoid dropping their fields
+		if len(query.Includes) > 0 {
+			var resp struct {
+				Services []map[string]any `json:"services"`
+				Offset   int              `json:"offset"`
+				More     bool             `json:"more"`
+				Total    int              `json:"total"`
+			}
+			if err := c.GetJSONWithArrayParamsContext(ctx, "/services", query.ToArrayParams(), &resp); err != nil {
+				return toolError(err), nil
+			}
+			return servicesListResult(resp.Services, resp.Offset, resp.More, resp.Total, args)
 		}
 
 		var resp models.ServicesResponse
-		if err := c.GetJSON("/services", params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithArrayParamsContext(ctx, "/services", query.ToArrayParams(), &resp); err != nil {
+			return toolError(err), nil
 		}
+		return servicesListResult(resp.Services, resp.Offset, resp.More, resp.Total, args)
+	}
+}
 
-		result := models.ListResponse[models.Service]{Response: resp.Services}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+// servicesListResult returns a page of services as a list result, applying
+// the fields argument if set
+func servicesListResult[T any](services []T, offset int, more bool, total int, args map[string]any) (*mcp.CallToolResult, error) {
+	if v, ok := getString(args, "fields"); ok {
+		projected, err := projectFields(services, splitAndTrim(v))
+		if err != nil {
+			return toolError(err), nil
+		}
+		return listResult(models.ListResponse[any]{Response: projected}.WithPage(offset, more, total)), nil
 	}
+	return listResult(models.ListResponse[T]{Response: services}.WithPage(offset, more, total)), nil
 }
 
 func getServiceHandler(c *client.Client) server.ToolHandlerFunc {
@@ -89,8 +178,8 @@ func getServiceHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.ServiceResponse
-		if err := c.GetJSON(fmt.Sprintf("/services/%s", serviceID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/services/%s", serviceID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Service)
@@ -127,8 +216,8 @@ func createServiceHandler(c *client.Client) server.ToolHandlerFunc {
 		req := models.ServiceCreateRequest{Service: service}
 
 		var resp models.ServiceResponse
-		if err := c.PostJSON("/services", req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PostJSONWithContext(ctx, "/services", req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Service)
@@ -164,11 +253,137 @@ func updateServiceHandler(c *client.Client) server.ToolHandlerFunc {
 		req := models.ServiceUpdateRequest{Service: service}
 
 		var resp models.ServiceResponse
-		if err := c.PutJSON(fmt.Sprintf("/services/%s", serviceID), req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/services/%s", serviceID), req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Service)
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
+
+func deleteServiceHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		serviceID, ok := getString(args, "service_id")
+		if !ok {
+			return mcp.NewToolResultError("service_id is required"), nil
+		}
+
+		if _, err := c.DeleteWithContext(ctx, fmt.Sprintf("/services/%s", serviceID)); err != nil {
+			if hasOpenIncidentsError(err) {
+				return toolError(fmt.Errorf(
+					"service %s has open incidents and cannot be deleted. Resolve them first; use list_incidents with service_ids '%s' and statuses 'triggered,acknowledged' to find them: %w",
+					serviceID, serviceID, err)), nil
+			}
+			return toolError(err), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Service %s deleted successfully", serviceID)), nil
+	}
+}
+
+// hasOpenIncidentsError reports whether PagerDuty rejected a service deletion
+// because the service still has open incidents
+func hasOpenIncidentsError(err error) bool {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode < 400 || apiErr.StatusCode >= 500 {
+		return false
+	}
+	return strings.Contains(strings.ToLower(string(apiErr.Body)), "open incident")
+}
+
+func listServiceTeamsHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		serviceID, ok := getString(args, "service_id")
+		if !ok {
+			return mcp.NewToolResultError("service_id is required"), nil
+		}
+
+		var resp models.ServiceResponse
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/services/%s", serviceID), nil, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		result := models.ListResponse[models.TeamReference]{Response: resp.Service.Teams}
+		return listResult(result), nil
+	}
+}
+
+func addServiceTeamHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return updateServiceTeams(ctx, c, getArgs(request), true)
+	}
+}
+
+func removeServiceTeamHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return updateServiceTeams(ctx, c, getArgs(request), false)
+	}
+}
+
+// hasTeam reports whether teams contains the team ID
+func hasTeam(teams []models.TeamReference, teamID string) bool {
+	return slices.ContainsFunc(teams, func(team models.TeamReference) bool { return team.ID == teamID })
+}
+
+// updateServiceTeams reads the service's current teams, adds or removes
+// team_id, and writes the full list back. Adding a team the service already
+// has sends no update; removing one it doesn't have is an error. PagerDuty can
+// derive a service's teams from its escalation policy and ignore the update, so
+// the returned teams are checked and an unapplied change is reported as an
+// error rather than success.
+func updateServiceTeams(ctx context.Context, c *client.Client, args map[string]any, add bool) (*mcp.CallToolResult, error) {
+	serviceID, ok := getString(args, "service_id")
+	if !ok {
+		return mcp.NewToolResultError("service_id is required"), nil
+	}
+	teamID, ok := getString(args, "team_id")
+	if !ok {
+		return mcp.NewToolResultError("team_id is required"), nil
+	}
+	if !looksLikeID(teamID) {
+		return mcp.NewToolResultError(fmt.Sprintf("team_id must be a PagerDuty team ID (e.g., 'PTEAM123'), got '%s'", teamID)), nil
+	}
+
+	var current models.ServiceResponse
+	if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/services/%s", serviceID), nil, &current); err != nil {
+		return toolError(err), nil
+	}
+
+	teams := current.Service.Teams
+	switch {
+	case add && hasTeam(teams, teamID):
+		return listResult(models.ListResponse[models.TeamReference]{Response: teams}), nil
+	case add:
+		teams = append(slices.Clone(teams), models.TeamReference{ID: teamID})
+	case !hasTeam(teams, teamID):
+		return mcp.NewToolResultError(fmt.Sprintf("service %s does not belong to team %s; use list_service_teams to see its teams", serviceID, teamID)), nil
+	default:
+		teams = slices.DeleteFunc(slices.Clone(teams), func(team models.TeamReference) bool { return team.ID == teamID })
+	}
+
+	// Send bare references so the API doesn't reject read-only fields
+	refs := make([]models.TeamReference, len(teams))
+	for i, team := range teams {
+		refs[i] = models.TeamReference{ID: team.ID, Type: "team_reference"}
+	}
+	req := models.ServiceTeamsUpdateRequest{Service: models.ServiceTeamsUpdate{Type: "service", Teams: refs}}
+
+	var resp models.ServiceResponse
+	if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/services/%s", serviceID), req, &resp); err != nil {
+		return toolError(err), nil
+	}
+
+	if hasTeam(resp.Service.Teams, teamID) != add {
+		policy := "its escalation policy"
+		if ep := current.Service.EscalationPolicy; ep != nil && ep.ID != "" {
+			policy = fmt.Sprintf("its escalation policy %s", referenceLabel(ep.Summary, ep.ID))
+		}
+		return toolError(fmt.Errorf("PagerDuty accepted the update but did not change the teams of service %s. A service's teams follow %s; change the teams on the escalation policy instead", serviceID, policy)), nil
+	}
+
+	result := models.ListResponse[models.TeamReference]{Response: resp.Service.Teams}
+	return listResult(result), nil
+}
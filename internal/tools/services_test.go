@@ -0,0 +1,231 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestListServices_TeamIDs tests that each team ID is sent as its own team_ids[] query value
+func TestListServices_TeamIDs(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"services":[]}`))
+	})
+
+	result, err := listServicesHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"team_ids": "PTEAM1,PTEAM2",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	teamIDs := query["team_ids[]"]
+	if len(teamIDs) != 2 || teamIDs[0] != "PTEAM1" || teamIDs[1] != "PTEAM2" {
+		t.Errorf("Expected team_ids[] to be [PTEAM1 PTEAM2], got %v", teamIDs)
+	}
+}
+
+// TestDeleteService tests that the service is DELETEd and a success message returned
+func TestDeleteService(t *testing.T) {
+	var method, path string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	result, err := deleteServiceHandler(c)(context.Background(), newToolRequest(map[string]any{"service_id": "PSVC1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if method != http.MethodDelete || path != "/services/PSVC1" {
+		t.Errorf("Expected DELETE /services/PSVC1, got %s %s", method, path)
+	}
+}
+
+// TestDeleteService_OpenIncidents tests that the open-incidents rejection is a
+// structured error carrying guidance
+func TestDeleteService_OpenIncidents(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"message":"Invalid Input Provided","code":2001,"errors":["Service has open incidents."]}}`))
+	})
+
+	result, _ := deleteServiceHandler(c)(context.Background(), newToolRequest(map[string]any{"service_id": "PSVC1"}))
+	payload := decodeToolError(t, result)
+	if status, _ := payload["status"].(float64); status != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %v", payload["status"])
+	}
+	if guidance, _ := payload["context"].(string); !strings.Contains(guidance, "has open incidents") {
+		t.Errorf("Expected open incidents guidance, got %v", payload)
+	}
+}
+
+// TestListServices_Include tests that include values are sent as include[] and included objects keep all their fields
+func TestListServices_Include(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"services":[{"id":"PSVC1","name":"API","integrations":[{"id":"PINT1","type":"generic_events_api_inbound_integration","integration_key":"abc123"}]}]}`))
+	})
+
+	result, err := listServicesHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"include": "integrations,teams",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	includes := query["include[]"]
+	if len(includes) != 2 || includes[0] != "integrations" || includes[1] != "teams" {
+		t.Errorf("Expected include[] to be [integrations teams], got %v", includes)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, `"integration_key":"abc123"`) {
+		t.Errorf("Expected included integration fields to be preserved, got %s", text)
+	}
+}
+
+// TestAddServiceTeam tests that the new team is appended to the service's existing teams
+func TestAddServiceTeam(t *testing.T) {
+	var body models.ServiceTeamsUpdateRequest
+	puts := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/PSVC1" {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"service":{"id":"PSVC1","teams":[{"id":"PTEAM1","type":"team_reference","summary":"Platform"}]}}`))
+		case http.MethodPut:
+			puts++
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode body: %v", err)
+			}
+			w.Write([]byte(`{"service":{"id":"PSVC1","teams":[{"id":"PTEAM1"},{"id":"PTEAM2"}]}}`))
+		}
+	})
+
+	result, err := addServiceTeamHandler(c)(context.Background(), newToolRequest(map[string]any{"service_id": "PSVC1", "team_id": "PTEAM2"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	teams := body.Service.Teams
+	if len(teams) != 2 || teams[0].ID != "PTEAM1" || teams[1].ID != "PTEAM2" || teams[0].Summary != "" {
+		t.Errorf("Expected bare references to [PTEAM1 PTEAM2], got %+v", teams)
+	}
+
+	// Adding a team the service already has sends no update
+	if _, err := addServiceTeamHandler(c)(context.Background(), newToolRequest(map[string]any{"service_id": "PSVC1", "team_id": "PTEAM1"})); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if puts != 1 {
+		t.Errorf("Expected 1 update, got %d", puts)
+	}
+}
+
+// TestServiceTeams_NotApplied tests that an update PagerDuty ignores is
+// reported as an error naming the escalation policy, and that removing a team
+// the service doesn't have fails without an update
+func TestServiceTeams_NotApplied(t *testing.T) {
+	puts := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts++
+		}
+		// The service's teams never change, as when they follow the escalation policy
+		w.Write([]byte(`{"service":{"id":"PSVC1","escalation_policy":{"id":"PEP1","summary":"Platform EP"},"teams":[{"id":"PTEAM1"}]}}`))
+	})
+
+	result, err := addServiceTeamHandler(c)(context.Background(), newToolRequest(map[string]any{"service_id": "PSVC1", "team_id": "PTEAM2"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "Platform EP") {
+		t.Errorf("Expected an error naming the escalation policy, got %v", result.Content)
+	}
+
+	result, err = removeServiceTeamHandler(c)(context.Background(), newToolRequest(map[string]any{"service_id": "PSVC1", "team_id": "PTEAM1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Errorf("Expected an error when the team is not removed, got %v", result.Content)
+	}
+
+	puts = 0
+	result, err = removeServiceTeamHandler(c)(context.Background(), newToolRequest(map[string]any{"service_id": "PSVC1", "team_id": "PTEAM9"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError || puts != 0 {
+		t.Errorf("Expected an error and no update for a team the service lacks, got %v after %d updates", result.Content, puts)
+	}
+}
+
+// TestGetServiceHealth tests that the service, open incident count, last
+// incident, and recent changes are combined, and a failed part becomes a warning
+func TestGetServiceHealth(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case r.URL.Path == "/services/PSVC1":
+			w.Write([]byte(`{"service":{"id":"PSVC1","name":"API","status":"critical"}}`))
+		case r.URL.Path == "/incidents" && len(q["statuses[]"]) == 2:
+			if q.Get("service_ids[]") != "PSVC1" || q.Get("total") != "true" {
+				t.Errorf("Unexpected open incidents query %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"incidents":[{"id":"PINC2"}],"total":3,"more":true}`))
+		case r.URL.Path == "/incidents":
+			if q.Get("sort_by") != "created_at:desc" || q.Get("limit") != "1" {
+				t.Errorf("Unexpected last incident query %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"incidents":[{"id":"PINC2","created_at":"2024-01-15T10:00:00Z"}]}`))
+		case r.URL.Path == "/services/PSVC1/change_events":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error":{"message":"Forbidden"}}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	result, err := getServiceHealthHandler(c)(context.Background(), newToolRequest(map[string]any{"service_id": "PSVC1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out models.ServiceHealth
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if out.Status != "critical" || out.Service.Summary != "API" || out.OpenIncidentCount != 3 {
+		t.Errorf("Unexpected health: %+v", out)
+	}
+	if out.LastIncidentAt.Format("2006-01-02T15:04") != "2024-01-15T10:00" {
+		t.Errorf("Expected last incident at 2024-01-15T10:00, got %v", out.LastIncidentAt)
+	}
+	if len(out.RecentChanges) != 0 || len(out.Warnings) != 1 || !strings.HasPrefix(out.Warnings[0], "recent changes unavailable") {
+		t.Errorf("Expected a recent changes warning, got %+v", out)
+	}
+}
@@ -97,18 +97,19 @@ func listStatusPagesHandler(c *client.Client) server.ToolHandlerFunc {
 		args := getArgs(request)
 		params := make(map[string]string)
 
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
 		}
 
 		var resp models.StatusPagesResponse
-		if err := c.GetJSON("/status_pages", params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, "/status_pages", params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		result := models.ListResponse[models.StatusPage]{Response: resp.StatusPages}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		result := models.ListResponse[models.StatusPage]{Response: resp.StatusPages}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
 	}
 }
 
@@ -121,13 +122,12 @@ func listStatusPageSeveritiesHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.StatusPageSeveritiesResponse
-		if err := c.GetJSON(fmt.Sprintf("/status_pages/%s/severities", statusPageID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/status_pages/%s/severities", statusPageID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		result := models.ListResponse[models.StatusPageSeverity]{Response: resp.Severities}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		return listResult(result), nil
 	}
 }
 
@@ -140,13 +140,12 @@ func listStatusPageImpactsHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.StatusPageImpactsResponse
-		if err := c.GetJSON(fmt.Sprintf("/status_pages/%s/impacts", statusPageID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/status_pages/%s/impacts", statusPageID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		result := models.ListResponse[models.StatusPageImpact]{Response: resp.Impacts}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		return listResult(result), nil
 	}
 }
 
@@ -159,13 +158,12 @@ func listStatusPageStatusesHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.StatusPageStatusesResponse
-		if err := c.GetJSON(fmt.Sprintf("/status_pages/%s/statuses", statusPageID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/status_pages/%s/statuses", statusPageID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		result := models.ListResponse[models.StatusPageStatus]{Response: resp.Statuses}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		return listResult(result), nil
 	}
 }
 
@@ -183,8 +181,8 @@ func getStatusPagePostHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.StatusPagePostResponse
-		if err := c.GetJSON(fmt.Sprintf("/status_pages/%s/posts/%s", statusPageID, postID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/status_pages/%s/posts/%s", statusPageID, postID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Post)
@@ -206,13 +204,12 @@ func listStatusPagePostUpdatesHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.StatusPagePostUpdatesResponse
-		if err := c.GetJSON(fmt.Sprintf("/status_pages/%s/posts/%s/post_updates", statusPageID, postID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/status_pages/%s/posts/%s/post_updates", statusPageID, postID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		result := models.ListResponse[models.StatusPagePostUpdate]{Response: resp.PostUpdates}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		return listResult(result), nil
 	}
 }
 
@@ -252,18 +249,22 @@ func createStatusPagePostHandler(c *client.Client) server.ToolHandlerFunc {
 				Type: "status_page_severity_reference",
 			}
 		}
-		if v, ok := getString(args, "starts_at"); ok {
+		if v, ok, err := getDateTime(args, "starts_at"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
 			post.StartsAt = v
 		}
-		if v, ok := getString(args, "ends_at"); ok {
+		if v, ok, err := getDateTime(args, "ends_at"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
 			post.EndsAt = v
 		}
 
 		req := models.StatusPagePostCreateRequestWrapper{Post: post}
 
 		var resp models.StatusPagePostResponse
-		if err := c.PostJSON(fmt.Sprintf("/status_pages/%s/posts", statusPageID), req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PostJSONWithContext(ctx, fmt.Sprintf("/status_pages/%s/posts", statusPageID), req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Post)
@@ -313,8 +314,8 @@ func createStatusPagePostUpdateHandler(c *client.Client) server.ToolHandlerFunc
 		req := models.StatusPagePostUpdateRequestWrapper{PostUpdate: update}
 
 		var resp models.StatusPagePostUpdateResponse
-		if err := c.PostJSON(fmt.Sprintf("/status_pages/%s/posts/%s/post_updates", statusPageID, postID), req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PostJSONWithContext(ctx, fmt.Sprintf("/status_pages/%s/posts/%s/post_updates", statusPageID, postID), req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.PostUpdate)
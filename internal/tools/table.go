@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// tableFormats are the format argument values accepted by list tools that can
+// return a table instead of JSON
+var tableFormats = []string{"json", "csv", "markdown"}
+
+// getTableFormat reads the format argument, defaulting to json
+func getTableFormat(args map[string]any) (string, error) {
+	format, ok := getString(args, "format")
+	if !ok {
+		return "json", nil
+	}
+	if slices.Contains(tableFormats, format) {
+		return format, nil
+	}
+	return "", fmt.Errorf("invalid format '%s': expected json, csv, or markdown", format)
+}
+
+// tableResult renders a list response as a csv or markdown table with one
+// column per field path (dot notation for nested fields, e.g.
+// "service.summary"). The list summary follows as a second content item so
+// the table text stays machine-readable.
+func tableResult[T any](result models.ListResponse[T], columns []string, format string) (*mcp.CallToolResult, error) {
+	data, err := json.Marshal(result.Response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal items: %w", err)
+	}
+	var items []any
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal items: %w", err)
+	}
+
+	rows := make([][]string, len(items))
+	for i, item := range items {
+		rows[i] = make([]string, len(columns))
+		for j, column := range columns {
+			rows[i][j] = tableCell(lookupFieldPath(item, column))
+		}
+	}
+
+	var table string
+	if format == "csv" {
+		table, err = csvTable(columns, rows)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		table = markdownTable(columns, rows)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(table),
+			mcp.NewTextContent(result.Summary()),
+		},
+	}, nil
+}
+
+// lookupFieldPath returns the value at a dot-separated path in decoded JSON
+func lookupFieldPath(v any, path string) any {
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = obj[strings.TrimSpace(key)]
+	}
+	return v
+}
+
+// tableCell formats a decoded JSON value for a table cell. Objects and arrays
+// are written as compact JSON.
+func tableCell(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	default:
+		data, _ := json.Marshal(val)
+		return string(data)
+	}
+}
+
+func csvTable(columns []string, rows [][]string) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(columns); err != nil {
+		return "", err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func markdownTable(columns []string, rows [][]string) string {
+	var sb strings.Builder
+	writeRow := func(cells []string) {
+		sb.WriteString("|")
+		for _, cell := range cells {
+			cell = strings.ReplaceAll(cell, "|", `\|`)
+			cell = strings.Join(strings.Fields(cell), " ")
+			sb.WriteString(" " + cell + " |")
+		}
+		sb.WriteString("\n")
+	}
+
+	writeRow(columns)
+	sb.WriteString("|")
+	for range columns {
+		sb.WriteString("---|")
+	}
+	sb.WriteString("\n")
+	for _, row := range rows {
+		writeRow(row)
+	}
+	return sb.String()
+}
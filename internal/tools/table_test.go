@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// incidentTableJSON is a page of incidents used by the table output tests
+const incidentTableJSON = `{"incidents":[
+	{"id":"PINC1","incident_number":42,"title":"DB down, replica lagging","status":"triggered","urgency":"high","service":{"id":"PSVC1","summary":"API | prod"},"created_at":"2024-01-15T10:00:00Z"},
+	{"id":"PINC2","incident_number":43,"title":"Disk full","status":"acknowledged","urgency":"low","created_at":"2024-01-15T11:00:00Z"}
+],"offset":0,"more":true,"total":5}`
+
+// TestListIncidents_CSV tests that incidents are flattened into csv rows with
+// quoting, and the summary is returned separately
+func TestListIncidents_CSV(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(incidentTableJSON))
+	})
+
+	result, err := listIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{"format": "csv"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if len(result.Content) != 2 {
+		t.Fatalf("Expected table and summary content, got %d items", len(result.Content))
+	}
+
+	want := "incident_number,title,status,urgency,service.summary,created_at\n" +
+		`42,"DB down, replica lagging",triggered,high,API | prod,2024-01-15T10:00:00Z` + "\n" +
+		"43,Disk full,acknowledged,low,,2024-01-15T11:00:00Z\n"
+	if got := result.Content[0].(mcp.TextContent).Text; got != want {
+		t.Errorf("Unexpected csv:\n%s\nwant:\n%s", got, want)
+	}
+	if summary := result.Content[1].(mcp.TextContent).Text; !strings.Contains(summary, "request offset 2") {
+		t.Errorf("Expected a pagination hint in the summary, got %q", summary)
+	}
+}
+
+// TestListIncidents_Markdown tests that fields selects the columns and cell
+// text is escaped for markdown
+func TestListIncidents_Markdown(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(incidentTableJSON))
+	})
+
+	result, err := listIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"format": "markdown",
+		"fields": "id,service.summary",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	want := "| id | service.summary |\n|---|---|\n| PINC1 | API \\| prod |\n| PINC2 |  |\n"
+	if got := result.Content[0].(mcp.TextContent).Text; got != want {
+		t.Errorf("Unexpected markdown:\n%s\nwant:\n%s", got, want)
+	}
+
+	result, _ = listIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{"format": "xml"}))
+	if !result.IsError {
+		t.Error("Expected an error for an unknown format")
+	}
+}
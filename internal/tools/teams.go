@@ -3,7 +3,9 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
@@ -20,6 +22,7 @@ func RegisterTeamReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithString("query", mcp.Description("Filter teams by name (partial match supported)")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+		mcp.WithNumber("offset", mcp.Description("Number of records to skip. Pass next_offset from the previous response to fetch the next page."), mcp.Min(0)),
 	), listTeamsHandler(c))
 
 	// get_team
@@ -38,6 +41,14 @@ func RegisterTeamReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("team_id", mcp.Required(), mcp.Description("The unique team ID (e.g., 'PTEAM123')")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
 	), listTeamMembersHandler(c))
+
+	// get_team_resources
+	s.AddTool(mcp.NewTool("get_team_resources",
+		mcp.WithDescription("List the services and escalation policies a team owns in one call. Use to answer 'what does this team own?' or to assess the impact of team changes."),
+		mcp.WithTitleAnnotation("Get Team Resources"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("team_id", mcp.Required(), mcp.Description("The unique team ID (e.g., 'PTEAM123')")),
+	), getTeamResourcesHandler(c))
 }
 
 // RegisterTeamWriteTools registers write team tools
@@ -76,6 +87,16 @@ func RegisterTeamWriteTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("role", mcp.Description("Member role within the team"), mcp.Enum("manager", "responder", "observer")),
 	), addTeamMemberHandler(c))
 
+	// add_team_members
+	s.AddTool(mcp.NewTool("add_team_members",
+		mcp.WithDescription("Add several users to a team with the same role, e.g. when onboarding. Each user is added independently; the result lists which users were added and why any failed."),
+		mcp.WithTitleAnnotation("Add Team Members"),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithString("team_id", mcp.Required(), mcp.Description("The unique team ID (e.g., 'PTEAM123')")),
+		mcp.WithString("user_ids", mcp.Required(), mcp.Description("Comma-separated user IDs to add to the team (e.g., 'PUSER1,PUSER2')")),
+		mcp.WithString("role", mcp.Description("Member role within the team, applied to every user"), mcp.Enum("manager", "responder", "observer")),
+	), addTeamMembersHandler(c))
+
 	// remove_team_member
 	s.AddTool(mcp.NewTool("remove_team_member",
 		mcp.WithDescription("WARNING: DESTRUCTIVE - Remove a user from a team. The user will lose any team-specific permissions and may be removed from associated schedules and escalation policies."),
@@ -94,18 +115,24 @@ func listTeamsHandler(c *client.Client) server.ToolHandlerFunc {
 		if v, ok := getString(args, "query"); ok {
 			params["query"] = v
 		}
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
+		}
+		if v, ok, err := getInteger(args, "offset", 0, math.MaxInt); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["offset"] = fmt.Sprintf("%d", v)
 		}
 
 		var resp models.TeamsResponse
-		if err := c.GetJSON("/teams", params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, "/teams", params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		result := models.ListResponse[models.Team]{Response: resp.Teams}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		result := models.ListResponse[models.Team]{Response: resp.Teams}.WithPage(resp.Offset, resp.More, resp.Total)
+		return listResult(result), nil
 	}
 }
 
@@ -118,8 +145,8 @@ func getTeamHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		var resp models.TeamResponse
-		if err := c.GetJSON(fmt.Sprintf("/teams/%s", teamID), nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/teams/%s", teamID), nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Team)
@@ -136,18 +163,19 @@ func listTeamMembersHandler(c *client.Client) server.ToolHandlerFunc {
 		}
 
 		params := make(map[string]string)
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
 		}
 
 		var resp models.TeamMembersResponse
-		if err := c.GetJSON(fmt.Sprintf("/teams/%s/members", teamID), params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, fmt.Sprintf("/teams/%s/members", teamID), params, &resp); err != nil {
+			return toolError(err), nil
 		}
 
-		result := models.ListResponse[models.TeamMember]{Response: resp.Members}
-		data, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(data)), nil
+		result := models.ListResponse[models.TeamMember]{Response: resp.Members}.WithMore(resp.More, resp.Total)
+		return listResult(result), nil
 	}
 }
 
@@ -171,8 +199,8 @@ func createTeamHandler(c *client.Client) server.ToolHandlerFunc {
 		req := models.TeamCreateRequest{Team: team}
 
 		var resp models.TeamResponse
-		if err := c.PostJSON("/teams", req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PostJSONWithContext(ctx, "/teams", req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Team)
@@ -202,8 +230,8 @@ func updateTeamHandler(c *client.Client) server.ToolHandlerFunc {
 		req := models.TeamUpdateRequest{Team: team}
 
 		var resp models.TeamResponse
-		if err := c.PutJSON(fmt.Sprintf("/teams/%s", teamID), req, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.PutJSONWithContext(ctx, fmt.Sprintf("/teams/%s", teamID), req, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.Team)
@@ -219,8 +247,8 @@ func deleteTeamHandler(c *client.Client) server.ToolHandlerFunc {
 			return mcp.NewToolResultError("team_id is required"), nil
 		}
 
-		if _, err := c.Delete(fmt.Sprintf("/teams/%s", teamID)); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if _, err := c.DeleteWithContext(ctx, fmt.Sprintf("/teams/%s", teamID)); err != nil {
+			return toolError(err), nil
 		}
 
 		return mcp.NewToolResultText(fmt.Sprintf("Team %s deleted successfully", teamID)), nil
@@ -245,14 +273,73 @@ func addTeamMemberHandler(c *client.Client) server.ToolHandlerFunc {
 			member.Role = v
 		}
 
-		if _, err := c.Put(fmt.Sprintf("/teams/%s/users/%s", teamID, userID), member); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if _, err := c.PutWithContext(ctx, fmt.Sprintf("/teams/%s/users/%s", teamID, userID), member); err != nil {
+			return toolError(err), nil
 		}
 
 		return mcp.NewToolResultText(fmt.Sprintf("User %s added to team %s", userID, teamID)), nil
 	}
 }
 
+func addTeamMembersHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		teamID, ok := getString(args, "team_id")
+		if !ok {
+			return mcp.NewToolResultError("team_id is required"), nil
+		}
+
+		userIDs, ok := getStringArray(args, "user_ids")
+		if !ok {
+			return mcp.NewToolResultError("user_ids is required"), nil
+		}
+		for _, id := range userIDs {
+			if !looksLikeID(id) {
+				return mcp.NewToolResultError(fmt.Sprintf("user_ids must be PagerDuty user IDs (e.g., 'PUSER123'), got '%s'", id)), nil
+			}
+		}
+
+		member := models.TeamMemberAdd{}
+		if v, ok := getString(args, "role"); ok {
+			member.Role = v
+		}
+
+		// Keep going past individual failures so one bad ID doesn't block the rest
+		errs := forEachConcurrent(ctx, len(userIDs), maxConcurrentRequests, func(ctx context.Context, i int) error {
+			_, err := c.PutWithContext(ctx, fmt.Sprintf("/teams/%s/users/%s", teamID, userIDs[i]), member)
+			return err
+		})
+
+		result := models.TeamMembersAddResult{
+			TeamID: teamID,
+			Added:  []string{},
+			Failed: []models.TeamMemberAddFailure{},
+		}
+		for i, err := range errs {
+			// In a dry run every PUT is intercepted; report the first as the preview
+			var dryRun *client.DryRunError
+			if errors.As(err, &dryRun) {
+				return toolError(err), nil
+			}
+			if err != nil {
+				result.Failed = append(result.Failed, models.TeamMemberAddFailure{UserID: userIDs[i], Error: err.Error()})
+				continue
+			}
+			result.Added = append(result.Added, userIDs[i])
+		}
+		result.Summary = fmt.Sprintf("Added %d of %d user(s) to team %s", len(result.Added), len(userIDs), teamID)
+		if len(result.Failed) > 0 {
+			result.Summary += fmt.Sprintf("; %d failed", len(result.Failed))
+		}
+
+		data, err := json.Marshal(result)
+		if err != nil {
+			return toolError(err), nil
+		}
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
 func removeTeamMemberHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
@@ -266,10 +353,60 @@ func removeTeamMemberHandler(c *client.Client) server.ToolHandlerFunc {
 			return mcp.NewToolResultError("user_id is required"), nil
 		}
 
-		if _, err := c.Delete(fmt.Sprintf("/teams/%s/users/%s", teamID, userID)); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if _, err := c.DeleteWithContext(ctx, fmt.Sprintf("/teams/%s/users/%s", teamID, userID)); err != nil {
+			return toolError(err), nil
 		}
 
 		return mcp.NewToolResultText(fmt.Sprintf("User %s removed from team %s", userID, teamID)), nil
 	}
 }
+
+func getTeamResourcesHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		teamID, ok := getString(args, "team_id")
+		if !ok {
+			return mcp.NewToolResultError("team_id is required"), nil
+		}
+
+		params := map[string][]string{
+			"team_ids[]": {teamID},
+			"limit":      {fmt.Sprintf("%d", models.MaxPaginationLimit)},
+		}
+
+		var services models.ServicesResponse
+		var policies models.EscalationPoliciesResponse
+		errs := forEachConcurrent(ctx, 2, maxConcurrentRequests, func(ctx context.Context, i int) error {
+			if i == 0 {
+				return c.GetJSONWithArrayParamsContext(ctx, "/services", params, &services)
+			}
+			return c.GetJSONWithArrayParamsContext(ctx, "/escalation_policies", params, &policies)
+		})
+		if errs[0] != nil {
+			return toolError(fmt.Errorf("failed to list services: %w", errs[0])), nil
+		}
+		if errs[1] != nil {
+			return toolError(fmt.Errorf("failed to list escalation policies: %w", errs[1])), nil
+		}
+
+		result := models.TeamResources{
+			TeamID:             teamID,
+			Services:           services.Services,
+			EscalationPolicies: policies.EscalationPolicies,
+		}
+		if result.Services == nil {
+			result.Services = []models.Service{}
+		}
+		if result.EscalationPolicies == nil {
+			result.EscalationPolicies = []models.EscalationPolicy{}
+		}
+		result.Summary = fmt.Sprintf("Team %s owns %d service(s) and %d escalation policy(ies)",
+			teamID, len(result.Services), len(result.EscalationPolicies))
+		if services.More || policies.More {
+			result.Summary += fmt.Sprintf(". WARNING: Only the first %d of each are included.", models.MaxPaginationLimit)
+		}
+
+		data, _ := json.Marshal(result)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
@@ -0,0 +1,117 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestGetTeamResources tests that services and escalation policies filtered by team are combined
+func TestGetTeamResources(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("team_ids[]"); got != "PTEAM1" {
+			t.Errorf("Expected team_ids[]=PTEAM1, got '%s'", got)
+		}
+		switch r.URL.Path {
+		case "/services":
+			w.Write([]byte(`{"services":[{"id":"PSVC1","name":"API"},{"id":"PSVC2","name":"Worker"}]}`))
+		case "/escalation_policies":
+			w.Write([]byte(`{"escalation_policies":[{"id":"PEP1","name":"Platform"}]}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	result, err := getTeamResourcesHandler(c)(context.Background(), newToolRequest(map[string]any{"team_id": "PTEAM1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out models.TeamResources
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Services) != 2 || len(out.EscalationPolicies) != 1 {
+		t.Errorf("Expected 2 services and 1 escalation policy, got %+v", out)
+	}
+	if out.Summary != "Team PTEAM1 owns 2 service(s) and 1 escalation policy(ies)" {
+		t.Errorf("Unexpected summary '%s'", out.Summary)
+	}
+}
+
+// TestListTeams_Offset tests that offset is forwarded for paging and negative offsets are rejected
+func TestListTeams_Offset(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"teams":[],"offset":50,"more":false}`))
+	})
+
+	result, err := listTeamsHandler(c)(context.Background(), newToolRequest(map[string]any{"offset": float64(50), "limit": float64(25)}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if query.Get("offset") != "50" || query.Get("limit") != "25" {
+		t.Errorf("Expected offset=50 and limit=25, got %v", query)
+	}
+
+	result, err = listTeamsHandler(c)(context.Background(), newToolRequest(map[string]any{"offset": float64(-1)}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result for a negative offset")
+	}
+}
+
+// TestAddTeamMembers tests that every user is added and failures are reported per user
+func TestAddTeamMembers(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path == "/teams/PTEAM1/users/PBAD" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"message":"Not Found","code":2100}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	result, err := addTeamMembersHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"team_id":  "PTEAM1",
+		"user_ids": "PUSER1,PBAD,PUSER2",
+		"role":     "responder",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out models.TeamMembersAddResult
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Added) != 2 || out.Added[0] != "PUSER1" || out.Added[1] != "PUSER2" {
+		t.Errorf("Expected [PUSER1 PUSER2] added, got %v", out.Added)
+	}
+	if len(out.Failed) != 1 || out.Failed[0].UserID != "PBAD" {
+		t.Errorf("Expected PBAD to fail, got %+v", out.Failed)
+	}
+	if out.Summary != "Added 2 of 3 user(s) to team PTEAM1; 1 failed" {
+		t.Errorf("Unexpected summary '%s'", out.Summary)
+	}
+}
@@ -0,0 +1,61 @@
+package tools
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+)
+
+// timeZoneDescription describes the time_zone argument of tools that localize
+// their timestamps
+const timeZoneDescription = "IANA time zone to render returned times in (e.g., 'America/New_York', 'UTC'). Defaults to the times as PagerDuty returns them."
+
+// getTimeZone loads the IANA time zone named by the time_zone argument
+func getTimeZone(args map[string]any) (*time.Location, bool, error) {
+	name, ok := getString(args, "time_zone")
+	if !ok {
+		return nil, false, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, false, fmt.Errorf("time_zone must be an IANA time zone such as 'America/New_York', got '%s'", name)
+	}
+	return loc, true, nil
+}
+
+// localizeIncident renders the incident's timestamps in loc
+func localizeIncident(incident *models.Incident, loc *time.Location) {
+	incident.CreatedAt = incident.CreatedAt.InLocation(loc)
+	incident.UpdatedAt = incident.UpdatedAt.InLocation(loc)
+	incident.LastStatusChangeAt = incident.LastStatusChangeAt.InLocation(loc)
+}
+
+// localizeOncalls renders each on-call entry's start and end in loc
+func localizeOncalls(oncalls []models.Oncall, loc *time.Location) {
+	for i := range oncalls {
+		oncalls[i].Start = oncalls[i].Start.InLocation(loc)
+		oncalls[i].End = oncalls[i].End.InLocation(loc)
+	}
+}
+
+// localizeSchedule renders the schedule's rendered entries in loc
+func localizeSchedule(schedule *models.Schedule, loc *time.Location) {
+	for i := range schedule.ScheduleLayers {
+		localizeRenderedEntries(schedule.ScheduleLayers[i].RenderedScheduleEntries, loc)
+	}
+	if schedule.OverridesSubschedule != nil {
+		localizeRenderedEntries(schedule.OverridesSubschedule.RenderedScheduleEntries, loc)
+	}
+	if schedule.FinalSchedule != nil {
+		localizeRenderedEntries(schedule.FinalSchedule.RenderedScheduleEntries, loc)
+	}
+}
+
+// localizeRenderedEntries renders each entry's start and end in loc
+func localizeRenderedEntries(entries []models.RenderedScheduleEntry, loc *time.Location) {
+	for i := range entries {
+		entries[i].Start = entries[i].Start.InLocation(loc)
+		entries[i].End = entries[i].End.InLocation(loc)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
 	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
@@ -28,14 +29,23 @@ func RegisterUserReadTools(s *server.MCPServer, c *client.Client) {
 		mcp.WithString("query", mcp.Description("Filter users by name or email address (partial match supported)")),
 		mcp.WithString("team_ids", mcp.Description("Filter by team membership. Comma-separated team IDs (e.g., 'PTEAM1,PTEAM2')")),
 		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+		mcp.WithNumber("offset", mcp.Description("Number of records to skip. Pass next_offset from the previous response to fetch the next page."), mcp.Min(0)),
 	), listUsersHandler(c))
+
+	// get_user_context
+	s.AddTool(mcp.NewTool("get_user_context",
+		mcp.WithDescription("Get a consolidated view of a user: their profile, the teams they belong to, the on-call schedules they participate in, and their current on-call shifts. Use to answer 'who is this person and what are they responsible for?'"),
+		mcp.WithTitleAnnotation("Get User Context"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("user_id", mcp.Required(), mcp.Description("The ID of the user (e.g., 'PUSER123')")),
+	), getUserContextHandler(c))
 }
 
 func getUserDataHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		var resp models.UserResponse
-		if err := c.GetJSON("/users/me", nil, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithContext(ctx, "/users/me", nil, &resp); err != nil {
+			return toolError(err), nil
 		}
 
 		data, _ := json.Marshal(resp.User)
@@ -46,24 +56,105 @@ func getUserDataHandler(c *client.Client) server.ToolHandlerFunc {
 func listUsersHandler(c *client.Client) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := getArgs(request)
-		params := make(map[string]string)
+		params := make(map[string][]string)
 
 		if v, ok := getString(args, "query"); ok {
-			params["query"] = v
+			params["query"] = []string{v}
 		}
-		if v, ok := getString(args, "team_ids"); ok {
+		if v, ok := getStringArray(args, "team_ids"); ok {
 			params["team_ids[]"] = v
 		}
-		if v, ok := getNumber(args, "limit"); ok {
-			params["limit"] = fmt.Sprintf("%d", int(v))
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = []string{fmt.Sprintf("%d", v)}
+		}
+		if v, ok, err := getInteger(args, "offset", 0, math.MaxInt); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["offset"] = []string{fmt.Sprintf("%d", v)}
 		}
 
 		var resp models.UsersResponse
-		if err := c.GetJSON("/users", params, &resp); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		if err := c.GetJSONWithArrayParamsContext(ctx, "/users", params, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		result := models.ListResponse[models.User]{Response: resp.Users}.WithPage(resp.Offset, resp.More, resp.Total)
+		return listResult(result), nil
+	}
+}
+
+func getUserContextHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		userID, ok := getString(args, "user_id")
+		if !ok {
+			return mcp.NewToolResultError("user_id is required"), nil
+		}
+
+		var user models.UserResponse
+		var schedules models.SchedulesResponse
+		var oncalls models.OncallsResponse
+		errs := forEachConcurrent(ctx, 3, maxConcurrentRequests, func(ctx context.Context, i int) error {
+			switch i {
+			case 0:
+				return c.GetJSONWithArrayParamsContext(ctx, "/users/"+userID, map[string][]string{"include[]": {"teams"}}, &user)
+			case 1:
+				// The schedules endpoint cannot filter by user, so match members locally
+				return c.GetJSONWithArrayParamsContext(ctx, "/schedules", map[string][]string{
+					"limit": {fmt.Sprintf("%d", models.MaxPaginationLimit)},
+				}, &schedules)
+			default:
+				return c.GetJSONWithArrayParamsContext(ctx, "/oncalls", map[string][]string{
+					"user_ids[]": {userID},
+					"earliest":   {"true"},
+					"limit":      {fmt.Sprintf("%d", models.MaxPaginationLimit)},
+				}, &oncalls)
+			}
+		})
+		if errs[0] != nil {
+			return toolError(errs[0]), nil
+		}
+		if errs[1] != nil {
+			return toolError(fmt.Errorf("failed to list schedules: %w", errs[1])), nil
+		}
+		if errs[2] != nil {
+			return toolError(fmt.Errorf("failed to list on-calls: %w", errs[2])), nil
+		}
+
+		result := models.UserContext{
+			User:      user.User,
+			Teams:     user.User.Teams,
+			Schedules: []models.ScheduleReference{},
+			Oncalls:   oncalls.Oncalls,
+		}
+		if result.Teams == nil {
+			result.Teams = []models.TeamReference{}
+		}
+		if result.Oncalls == nil {
+			result.Oncalls = []models.Oncall{}
+		}
+		for _, schedule := range schedules.Schedules {
+			for _, member := range schedule.Users {
+				if member.ID == userID {
+					result.Schedules = append(result.Schedules, models.ScheduleReference{
+						ID:      schedule.ID,
+						Type:    "schedule_reference",
+						Summary: schedule.Name,
+						HTMLURL: schedule.HTMLURL,
+					})
+					break
+				}
+			}
+		}
+
+		result.Summary = fmt.Sprintf("%s belongs to %d team(s), participates in %d schedule(s), and has %d current on-call shift(s)",
+			user.User.Name, len(result.Teams), len(result.Schedules), len(result.Oncalls))
+		if schedules.More {
+			result.Summary += fmt.Sprintf(". WARNING: Only the first %d schedules in the account were checked.", models.MaxPaginationLimit)
 		}
 
-		result := models.ListResponse[models.User]{Response: resp.Users}
 		data, _ := json.Marshal(result)
 		return mcp.NewToolResultText(string(data)), nil
 	}
@@ -0,0 +1,56 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestGetUserContext tests that the user, their schedules, and their on-calls are combined
+func TestGetUserContext(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/PUSER1":
+			if got := r.URL.Query().Get("include[]"); got != "teams" {
+				t.Errorf("Expected include[]=teams, got '%s'", got)
+			}
+			w.Write([]byte(`{"user":{"id":"PUSER1","name":"Ada","teams":[{"id":"PTEAM1"}]}}`))
+		case "/schedules":
+			w.Write([]byte(`{"schedules":[
+				{"id":"PSCHED1","name":"Primary","users":[{"id":"PUSER2"},{"id":"PUSER1"}]},
+				{"id":"PSCHED2","name":"Secondary","users":[{"id":"PUSER2"}]}
+			]}`))
+		case "/oncalls":
+			q := r.URL.Query()
+			if q.Get("user_ids[]") != "PUSER1" || q.Get("earliest") != "true" {
+				t.Errorf("Unexpected on-call query %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"oncalls":[{"escalation_level":1,"schedule":{"id":"PSCHED1"}}]}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	result, err := getUserContextHandler(c)(context.Background(), newToolRequest(map[string]any{"user_id": "PUSER1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+
+	var out models.UserContext
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(out.Teams) != 1 || len(out.Oncalls) != 1 {
+		t.Errorf("Expected 1 team and 1 on-call, got %+v", out)
+	}
+	if len(out.Schedules) != 1 || out.Schedules[0].ID != "PSCHED1" {
+		t.Errorf("Expected only PSCHED1, got %+v", out.Schedules)
+	}
+}
@@ -1,8 +1,17 @@
 package tools
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
 	"strings"
+	"time"
 
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
@@ -30,6 +39,25 @@ func getNumber(args map[string]any, key string) (float64, bool) {
 	return 0, false
 }
 
+// getInteger extracts an integer argument, rejecting fractional values and values
+// outside [min, max]. Pass math.MaxInt as max for arguments with no upper bound.
+func getInteger(args map[string]any, key string, min, max int) (int, bool, error) {
+	v, ok := getNumber(args, key)
+	if !ok {
+		return 0, false, nil
+	}
+	if v != math.Trunc(v) {
+		return 0, false, fmt.Errorf("invalid %s: expected a whole number, got %v", key, v)
+	}
+	if v < float64(min) || v > float64(max) {
+		if max == math.MaxInt {
+			return 0, false, fmt.Errorf("invalid %s: must be at least %d, got %v", key, min, v)
+		}
+		return 0, false, fmt.Errorf("invalid %s: must be between %d and %d, got %v", key, min, max, v)
+	}
+	return int(v), true, nil
+}
+
 // getBool extracts a boolean argument
 func getBool(args map[string]any, key string) (bool, bool) {
 	if v, ok := args[key].(bool); ok {
@@ -38,6 +66,83 @@ func getBool(args map[string]any, key string) (bool, bool) {
 	return false, false
 }
 
+// dateTimeLayouts are the ISO 8601 forms accepted for date arguments, tried in order.
+// Layouts without an offset are interpreted as UTC.
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// parseDateTime parses an ISO 8601 date argument, returning a descriptive error
+func parseDateTime(key, value string) (time.Time, error) {
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid %s format: expected ISO 8601 (e.g., '2024-01-15T10:00:00Z'), got '%s'", key, value)
+}
+
+// getDateTime extracts an optional ISO 8601 date argument, validates it, and
+// returns it in canonical RFC3339 form
+func getDateTime(args map[string]any, key string) (string, bool, error) {
+	v, ok := getString(args, key)
+	if !ok {
+		return "", false, nil
+	}
+	t, err := parseDateTime(key, v)
+	if err != nil {
+		return "", false, err
+	}
+	return t.Format(time.RFC3339), true, nil
+}
+
+// pagerDutyIDPattern loosely matches PagerDuty object IDs such as 'PUSER123'.
+// It only catches obvious mistakes like names or emails; the API validates the rest.
+var pagerDutyIDPattern = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+
+// looksLikeID reports whether value could be a PagerDuty object ID
+func looksLikeID(value string) bool {
+	return pagerDutyIDPattern.MatchString(value)
+}
+
+// referenceLabel prefers a reference's summary, falling back to its ID
+func referenceLabel(summary, id string) string {
+	if summary != "" {
+		return summary
+	}
+	return id
+}
+
+// isHTTPURL reports whether value is an absolute http or https URL
+func isHTTPURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// getStringArray extracts a list argument given either as a comma-separated
+// string or a JSON array of strings, so each value can be sent as its own
+// array query parameter
+func getStringArray(args map[string]any, key string) ([]string, bool) {
+	var values []string
+	switch v := args[key].(type) {
+	case string:
+		values = splitAndTrim(v)
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				if s = strings.TrimSpace(s); s != "" {
+					values = append(values, s)
+				}
+			}
+		}
+	}
+	return values, len(values) > 0
+}
+
 // splitAndTrim splits a comma-separated string and trims whitespace
 func splitAndTrim(s string) []string {
 	parts := strings.Split(s, ",")
@@ -50,3 +155,143 @@ func splitAndTrim(s string) []string {
 	}
 	return result
 }
+
+// fieldTree is a set of selected fields; a nil subtree keeps the whole value
+type fieldTree map[string]fieldTree
+
+// parseFieldTree builds a fieldTree from field paths. Paths use dot notation
+// for nested fields (e.g. "service.summary"); selecting a parent field keeps
+// it whole even if nested paths under it are also given.
+func parseFieldTree(fields []string) fieldTree {
+	tree := fieldTree{}
+	for _, field := range fields {
+		var parts []string
+		for _, p := range strings.Split(field, ".") {
+			if p = strings.TrimSpace(p); p != "" {
+				parts = append(parts, p)
+			}
+		}
+
+		node := tree
+		for i, part := range parts {
+			child, exists := node[part]
+			if exists && child == nil {
+				break
+			}
+			if i == len(parts)-1 {
+				node[part] = nil
+				break
+			}
+			if !exists {
+				child = fieldTree{}
+				node[part] = child
+			}
+			node = child
+		}
+	}
+	return tree
+}
+
+// apply returns a copy of v containing only the selected fields. Arrays are
+// projected element by element and scalars are returned unchanged.
+func (t fieldTree) apply(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for key, sub := range t {
+			fv, ok := val[key]
+			if !ok {
+				continue
+			}
+			if sub == nil {
+				out[key] = fv
+			} else {
+				out[key] = sub.apply(fv)
+			}
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i := range val {
+			out[i] = t.apply(val[i])
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// projectFields keeps only the selected JSON fields of each item
+func projectFields[T any](items []T, fields []string) ([]any, error) {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal items: %w", err)
+	}
+	var raw []any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal items: %w", err)
+	}
+
+	tree := parseFieldTree(fields)
+	projected := make([]any, len(raw))
+	for i, item := range raw {
+		projected[i] = tree.apply(item)
+	}
+	return projected, nil
+}
+
+// listResult marshals a list response together with its summary so the model
+// is warned when the results may be truncated. A nil list, which PagerDuty
+// returns for some empty responses, is marshaled as [] rather than null.
+func listResult[T any](result models.ListResponse[T]) *mcp.CallToolResult {
+	if result.Response == nil {
+		result.Response = []T{}
+	}
+	data, _ := json.Marshal(struct {
+		models.ListResponse[T]
+		Summary string `json:"summary"`
+	}{result, result.Summary()})
+	return mcp.NewToolResultText(string(data))
+}
+
+// dryRunResult reports the write request a dry run skipped. It is not an error
+// result: the tool validated its input and built the request successfully.
+func dryRunResult(dryRun *client.DryRunError) *mcp.CallToolResult {
+	data, _ := json.Marshal(map[string]any{
+		"dry_run": true,
+		"message": "Dry run: no changes were made in PagerDuty. This is the request that would have been sent.",
+		"request": map[string]any{
+			"method": dryRun.Method,
+			"path":   dryRun.Path,
+			"body":   dryRun.Body,
+		},
+	})
+	return mcp.NewToolResultText(string(data))
+}
+
+// toolError converts an error into an error tool result whose text is a JSON
+// object. PagerDuty API errors carry the API's error object and HTTP status,
+// e.g. {"error":{"message":"Not Found","code":2100},"status":404}, plus a
+// "context" field when the handler wrapped the API error with its own message;
+// other errors are reported as {"error":{"message":"..."}}.
+func toolError(err error) *mcp.CallToolResult {
+	// A dry run stops at the first write; report the request it would have sent
+	var dryRun *client.DryRunError
+	if errors.As(err, &dryRun) {
+		return dryRunResult(dryRun)
+	}
+
+	payload := map[string]any{}
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) {
+		payload["error"] = apiErr.Detail()
+		payload["status"] = apiErr.StatusCode
+		if wrapped := strings.TrimSuffix(err.Error(), ": "+apiErr.Error()); wrapped != err.Error() {
+			payload["context"] = wrapped
+		}
+	} else {
+		payload["error"] = map[string]string{"message": err.Error()}
+	}
+	data, _ := json.Marshal(payload)
+	return mcp.NewToolResultError(string(data))
+}
@@ -0,0 +1,300 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// decodeToolError parses the JSON payload of an error tool result
+func decodeToolError(t *testing.T, result *mcp.CallToolResult) map[string]any {
+	t.Helper()
+	if !result.IsError {
+		t.Fatal("Expected IsError to be true")
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("Expected text content, got %T", result.Content[0])
+	}
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(text.Text), &payload); err != nil {
+		t.Fatalf("Expected JSON error payload, got %q: %v", text.Text, err)
+	}
+	return payload
+}
+
+// TestToolError_APIError tests that wrapped API errors report the status and PagerDuty error object
+func TestToolError_APIError(t *testing.T) {
+	apiErr := &client.APIError{StatusCode: 404, Body: []byte(`{"error":{"message":"Not Found","code":2100}}`)}
+	payload := decodeToolError(t, toolError(fmt.Errorf("failed to get on-calls: %w", apiErr)))
+
+	if status, _ := payload["status"].(float64); status != 404 {
+		t.Errorf("Expected status 404, got %v", payload["status"])
+	}
+	detail, _ := payload["error"].(map[string]any)
+	if detail["message"] != "Not Found" || detail["code"] != float64(2100) {
+		t.Errorf("Expected PagerDuty error object, got %v", payload["error"])
+	}
+	if payload["context"] != "failed to get on-calls" {
+		t.Errorf("Expected the wrapping message as context, got %v", payload["context"])
+	}
+}
+
+// TestToolError_NonAPIError tests that other errors are reported without a status
+func TestToolError_NonAPIError(t *testing.T) {
+	payload := decodeToolError(t, toolError(errors.New("request failed: connection refused")))
+
+	if _, ok := payload["status"]; ok {
+		t.Errorf("Expected no status, got %v", payload["status"])
+	}
+	detail, _ := payload["error"].(map[string]any)
+	if detail["message"] != "request failed: connection refused" {
+		t.Errorf("Expected error message, got %v", payload["error"])
+	}
+}
+
+// TestListResult_IncludesSummary tests that list output carries both the records and the truncation summary
+func TestListResult_IncludesSummary(t *testing.T) {
+	items := make([]string, models.MaxPaginationLimit)
+	result := listResult(models.ListResponse[string]{Response: items})
+
+	var payload struct {
+		Response []string `json:"response"`
+		Summary  string   `json:"summary"`
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if err := json.Unmarshal([]byte(text), &payload); err != nil {
+		t.Fatalf("Failed to parse list output: %v", err)
+	}
+	if len(payload.Response) != models.MaxPaginationLimit {
+		t.Errorf("Expected %d records, got %d", models.MaxPaginationLimit, len(payload.Response))
+	}
+	if !strings.Contains(payload.Summary, "WARNING") {
+		t.Errorf("Expected truncation warning in summary, got '%s'", payload.Summary)
+	}
+}
+
+// TestListResult_EmptyUpstream tests that a list endpoint omitting its array yields "response":[] rather than null
+func TestListResult_EmptyUpstream(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"limit":25,"offset":0,"more":false}`))
+	})
+
+	result, err := listTeamsHandler(c)(context.Background(), newToolRequest(map[string]any{}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.HasPrefix(text, `{"response":[],`) {
+		t.Errorf("Expected an empty response array, got %s", text)
+	}
+}
+
+// TestListResult_PaginationMetadata tests that more, total, and next_offset are passed through from PagerDuty
+func TestListResult_PaginationMetadata(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"teams":[{"id":"PT1","name":"A"},{"id":"PT2","name":"B"}],"offset":40,"limit":2,"more":true,"total":45}`))
+	})
+
+	result, err := listTeamsHandler(c)(context.Background(), newToolRequest(map[string]any{}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var payload struct {
+		More       bool   `json:"more"`
+		Total      int    `json:"total"`
+		NextOffset int    `json:"next_offset"`
+		Summary    string `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &payload); err != nil {
+		t.Fatalf("Failed to parse list output: %v", err)
+	}
+	if !payload.More || payload.Total != 45 || payload.NextOffset != 42 {
+		t.Errorf("Expected more=true total=45 next_offset=42, got %+v", payload)
+	}
+	if !strings.Contains(payload.Summary, "offset 42") {
+		t.Errorf("Expected summary to point at the next offset, got '%s'", payload.Summary)
+	}
+}
+
+// TestListResult_NoOffsetArgument tests that a tool without an offset argument
+// reports more records without pointing at a next offset
+func TestListResult_NoOffsetArgument(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"priorities":[{"id":"P1","name":"P1"}],"offset":0,"limit":1,"more":true,"total":5}`))
+	})
+
+	result, err := listPrioritiesHandler(c)(context.Background(), newToolRequest(map[string]any{}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &payload); err != nil {
+		t.Fatalf("Failed to parse list output: %v", err)
+	}
+	if payload["more"] != true || payload["total"] != float64(5) {
+		t.Errorf("Expected more=true total=5, got %v", payload)
+	}
+	if _, ok := payload["next_offset"]; ok {
+		t.Errorf("Expected no next_offset, got %v", payload["next_offset"])
+	}
+	if summary, _ := payload["summary"].(string); strings.Contains(summary, "offset") {
+		t.Errorf("Expected summary not to suggest an offset, got '%s'", summary)
+	}
+}
+
+// TestProjectFields tests top-level, nested, and array field selection
+func TestProjectFields(t *testing.T) {
+	type ref struct {
+		ID      string `json:"id"`
+		Summary string `json:"summary"`
+	}
+	type item struct {
+		ID          string `json:"id"`
+		Title       string `json:"title"`
+		Status      string `json:"status"`
+		Service     ref    `json:"service"`
+		Assignments []ref  `json:"assignments"`
+	}
+	items := []item{{
+		ID:          "PABC123",
+		Title:       "Database down",
+		Status:      "triggered",
+		Service:     ref{ID: "PSVC1", Summary: "Database"},
+		Assignments: []ref{{ID: "PUSER1", Summary: "Alice"}, {ID: "PUSER2", Summary: "Bob"}},
+	}}
+
+	projected, err := projectFields(items, []string{"id", "service.summary", "assignments.id", "missing"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, _ := json.Marshal(projected)
+	want := `[{"assignments":[{"id":"PUSER1"},{"id":"PUSER2"}],"id":"PABC123","service":{"summary":"Database"}}]`
+	if string(data) != want {
+		t.Errorf("Expected %s, got %s", want, string(data))
+	}
+}
+
+// TestParseFieldTree_ParentWins tests that selecting a parent keeps it whole regardless of nested paths
+func TestParseFieldTree_ParentWins(t *testing.T) {
+	for _, fields := range [][]string{{"service", "service.id"}, {"service.id", "service"}} {
+		tree := parseFieldTree(fields)
+		if sub, ok := tree["service"]; !ok || sub != nil {
+			t.Errorf("Expected 'service' to be selected whole for %v, got %v", fields, tree)
+		}
+	}
+}
+
+// TestGetStringArray tests that comma-separated strings and JSON arrays are split into separate values
+func TestGetStringArray(t *testing.T) {
+	args := map[string]any{
+		"csv":   "PSVC1, PSVC2,,PSVC3",
+		"array": []any{"PTEAM1", " PTEAM2 ", ""},
+		"empty": " , ",
+	}
+
+	if got, ok := getStringArray(args, "csv"); !ok || strings.Join(got, "|") != "PSVC1|PSVC2|PSVC3" {
+		t.Errorf("Expected [PSVC1 PSVC2 PSVC3], got %v", got)
+	}
+	if got, ok := getStringArray(args, "array"); !ok || strings.Join(got, "|") != "PTEAM1|PTEAM2" {
+		t.Errorf("Expected [PTEAM1 PTEAM2], got %v", got)
+	}
+	if _, ok := getStringArray(args, "empty"); ok {
+		t.Error("Expected no values for an empty list")
+	}
+	if _, ok := getStringArray(args, "missing"); ok {
+		t.Error("Expected no values for a missing argument")
+	}
+}
+
+// TestGetDateTime tests that accepted ISO 8601 forms are normalized to RFC3339 and others are rejected
+func TestGetDateTime(t *testing.T) {
+	valid := map[string]string{
+		"2024-01-15T10:00:00Z":      "2024-01-15T10:00:00Z",
+		"2024-01-15T10:00:00.123Z":  "2024-01-15T10:00:00Z",
+		"2024-01-15T10:00:00-05:00": "2024-01-15T10:00:00-05:00",
+		"2024-01-15T10:00:00+0200":  "2024-01-15T10:00:00+02:00",
+		"2024-01-15T10:00:00":       "2024-01-15T10:00:00Z",
+		"2024-01-15T10:00":          "2024-01-15T10:00:00Z",
+		"2024-01-15":                "2024-01-15T00:00:00Z",
+	}
+	for input, want := range valid {
+		got, ok, err := getDateTime(map[string]any{"since": input}, "since")
+		if err != nil || !ok {
+			t.Errorf("Expected '%s' to be valid, got ok=%v err=%v", input, ok, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("Expected '%s' to normalize to '%s', got '%s'", input, want, got)
+		}
+	}
+
+	for _, input := range []string{"yesterday", "01/15/2024", "2024-13-01", "2024-01-15 10:00:00"} {
+		_, _, err := getDateTime(map[string]any{"since": input}, "since")
+		if err == nil {
+			t.Errorf("Expected '%s' to be rejected", input)
+			continue
+		}
+		if !strings.Contains(err.Error(), "invalid since format") {
+			t.Errorf("Expected error to name the argument, got '%s'", err.Error())
+		}
+	}
+
+	if _, ok, err := getDateTime(map[string]any{}, "since"); ok || err != nil {
+		t.Errorf("Expected missing argument to be absent without error, got ok=%v err=%v", ok, err)
+	}
+}
+
+// TestListIncidents_InvalidSince tests that an invalid date is rejected before calling the API
+func TestListIncidents_InvalidSince(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no API call, got %s %s", r.Method, r.URL.Path)
+	})
+
+	result, err := listIncidentsHandler(c)(context.Background(), newToolRequest(map[string]any{"since": "last tuesday"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Fatal("Expected IsError to be true")
+	}
+}
+
+// TestGetInteger tests that fractional and out-of-range values are rejected
+func TestGetInteger(t *testing.T) {
+	if v, ok, err := getInteger(map[string]any{"limit": float64(25)}, "limit", 1, 100); err != nil || !ok || v != 25 {
+		t.Errorf("Expected 25, got v=%d ok=%v err=%v", v, ok, err)
+	}
+	if _, ok, err := getInteger(map[string]any{}, "limit", 1, 100); ok || err != nil {
+		t.Errorf("Expected missing argument to be absent without error, got ok=%v err=%v", ok, err)
+	}
+
+	tests := map[float64]string{
+		1.5: "expected a whole number",
+		0:   "must be between 1 and 100",
+		101: "must be between 1 and 100",
+	}
+	for input, want := range tests {
+		_, _, err := getInteger(map[string]any{"limit": input}, "limit", 1, 100)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error containing '%s' for %v, got %v", want, input, err)
+		}
+	}
+
+	_, _, err := getInteger(map[string]any{"escalation_level": float64(-1)}, "escalation_level", 1, math.MaxInt)
+	if err == nil || !strings.Contains(err.Error(), "must be at least 1") {
+		t.Errorf("Expected lower bound error, got %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/client"
+	"github.com/jeremyproffitt/go-mcp-pagerduty/internal/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RegisterWebhookSubscriptionReadTools registers read-only webhook subscription tools
+func RegisterWebhookSubscriptionReadTools(s *server.MCPServer, c *client.Client) {
+	// list_webhook_subscriptions
+	s.AddTool(mcp.NewTool("list_webhook_subscriptions",
+		mcp.WithDescription("List webhook subscriptions that deliver PagerDuty events to outbound URLs, with their delivery URL, subscribed events, and whether they are active. Use this to find the subscription_id for test_webhook_subscription."),
+		mcp.WithTitleAnnotation("List Webhook Subscriptions"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithString("filter_type", mcp.Description("Only show subscriptions scoped to this kind of object"), mcp.Enum("account_reference", "service_reference", "team_reference")),
+		mcp.WithString("filter_id", mcp.Description("Only show subscriptions for this service or team ID; use with filter_type")),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return"), mcp.Min(1), mcp.Max(100)),
+		mcp.WithNumber("offset", mcp.Description("Number of records to skip. Pass next_offset from the previous response to fetch the next page."), mcp.Min(0)),
+	), listWebhookSubscriptionsHandler(c))
+}
+
+// RegisterWebhookSubscriptionWriteTools registers write webhook subscription tools
+func RegisterWebhookSubscriptionWriteTools(s *server.MCPServer, c *client.Client) {
+	// test_webhook_subscription
+	s.AddTool(mcp.NewTool("test_webhook_subscription",
+		mcp.WithDescription("Send a test event to a webhook subscription's delivery URL to confirm the receiving endpoint works. The delivery happens asynchronously, so check the receiving system to see whether it arrived."),
+		mcp.WithTitleAnnotation("Test Webhook Subscription"),
+		mcp.WithString("subscription_id", mcp.Required(), mcp.Description("The unique webhook subscription ID (e.g., 'PWHS123'); find it with list_webhook_subscriptions")),
+	), testWebhookSubscriptionHandler(c))
+}
+
+func listWebhookSubscriptionsHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		params := make(map[string]string)
+
+		if v, ok := getString(args, "filter_type"); ok {
+			params["filter_type"] = v
+		}
+		if v, ok := getString(args, "filter_id"); ok {
+			if params["filter_type"] == "" {
+				return mcp.NewToolResultError("filter_id requires filter_type"), nil
+			}
+			params["filter_id"] = v
+		}
+		if v, ok, err := getInteger(args, "limit", 1, 100); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["limit"] = fmt.Sprintf("%d", v)
+		}
+		if v, ok, err := getInteger(args, "offset", 0, math.MaxInt); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if ok {
+			params["offset"] = fmt.Sprintf("%d", v)
+		}
+
+		var resp models.WebhookSubscriptionsResponse
+		if err := c.GetJSONWithContext(ctx, "/webhook_subscriptions", params, &resp); err != nil {
+			return toolError(err), nil
+		}
+
+		result := models.ListResponse[models.WebhookSubscription]{Response: resp.WebhookSubscriptions}.WithPage(resp.Offset, resp.More, resp.Total)
+		return listResult(result), nil
+	}
+}
+
+func testWebhookSubscriptionHandler(c *client.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := getArgs(request)
+		subscriptionID, ok := getString(args, "subscription_id")
+		if !ok {
+			return mcp.NewToolResultError("subscription_id is required"), nil
+		}
+
+		if _, err := c.PostWithContext(ctx, fmt.Sprintf("/webhook_subscriptions/%s/ping", subscriptionID), nil); err != nil {
+			return toolError(err), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Test event queued for webhook subscription %s", subscriptionID)), nil
+	}
+}
@@ -0,0 +1,72 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestTestWebhookSubscription tests that a ping is posted to the subscription
+func TestTestWebhookSubscription(t *testing.T) {
+	var method, path string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	result, err := testWebhookSubscriptionHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"subscription_id": "PWHS1",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	if method != http.MethodPost || path != "/webhook_subscriptions/PWHS1/ping" {
+		t.Errorf("Expected POST /webhook_subscriptions/PWHS1/ping, got %s %s", method, path)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "PWHS1") {
+		t.Errorf("Expected result to mention the subscription, got %q", text)
+	}
+}
+
+// TestListWebhookSubscriptions tests that filters are passed through and the
+// delivery secret is not echoed
+func TestListWebhookSubscriptions(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/webhook_subscriptions" || q.Get("filter_type") != "service_reference" || q.Get("filter_id") != "PSVC1" {
+			t.Errorf("Unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"webhook_subscriptions":[{"id":"PWHS1","type":"webhook_subscription","active":true,
+			"delivery_method":{"type":"http_delivery_method","url":"https://hooks.example.com/pd","secret":"s3cr3t"},
+			"events":["incident.triggered"],"filter":{"type":"service_reference","id":"PSVC1"}}],"offset":0,"more":false,"total":1}`))
+	})
+
+	result, err := listWebhookSubscriptionsHandler(c)(context.Background(), newToolRequest(map[string]any{
+		"filter_type": "service_reference",
+		"filter_id":   "PSVC1",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error result: %v", result.Content)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.Contains(text, `"id":"PWHS1"`) || !strings.Contains(text, `"url":"https://hooks.example.com/pd"`) {
+		t.Errorf("Expected the subscription in the result, got %s", text)
+	}
+	if strings.Contains(text, "s3cr3t") {
+		t.Errorf("Expected the delivery secret to be omitted, got %s", text)
+	}
+
+	result, _ = listWebhookSubscriptionsHandler(c)(context.Background(), newToolRequest(map[string]any{"filter_id": "PSVC1"}))
+	if !result.IsError {
+		t.Error("Expected filter_id without filter_type to be rejected")
+	}
+}
@@ -0,0 +1,11 @@
+// Package version holds the build version shared by the server and client.
+package version
+
+// Version is the server version. Override it at build time with
+// -ldflags "-X github.com/jeremyproffitt/go-mcp-pagerduty/internal/version.Version=1.2.3"
+var Version = "0.1.0"
+
+// UserAgent returns the User-Agent sent with PagerDuty API requests
+func UserAgent() string {
+	return "go-mcp-pagerduty/" + Version
+}